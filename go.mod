@@ -5,6 +5,7 @@ go 1.21
 require (
 	github.com/hashicorp/hcl/v2 v2.17.0
 	github.com/terraform-linters/tflint-plugin-sdk v0.18.0
+	github.com/zclconf/go-cty v1.13.2
 )
 
 require (
@@ -24,7 +25,6 @@ require (
 	github.com/oklog/run v1.0.0 // indirect
 	github.com/vmihailenco/msgpack/v5 v5.3.5 // indirect
 	github.com/vmihailenco/tagparser/v2 v2.0.0 // indirect
-	github.com/zclconf/go-cty v1.13.2 // indirect
 	golang.org/x/net v0.12.0 // indirect
 	golang.org/x/sys v0.10.0 // indirect
 	golang.org/x/text v0.11.0 // indirect
@@ -8,11 +8,81 @@ import (
 
 func main() {
 	plugin.Serve(&plugin.ServeOpts{
-		RuleSet: &tflint.BuiltinRuleSet{
-			Name:    "takaishi",
-			Version: "0.0.1",
-			Rules: []tflint.Rule{
-				rules.NewModuleCircularDependencyRule(),
+		RuleSet: &rules.RuleSet{
+			BuiltinRuleSet: tflint.BuiltinRuleSet{
+				Name:    "takaishi",
+				Version: "0.0.1",
+				Rules: []tflint.Rule{
+					rules.NewModuleCircularDependencyRule(),
+					rules.NewModuleMissingSourceRule(),
+					rules.NewModulePinnedVersionRule(),
+					rules.NewModuleUnusedOutputRule(),
+					rules.NewModuleNamingConventionRule(),
+					rules.NewModuleProviderBlockRule(),
+					rules.NewModuleRequiredArgumentsRule(),
+					rules.NewModuleDuplicateSourceRule(),
+					rules.NewRegistryModuleVersionRequiredRule(),
+					rules.NewModuleSourceEscapeRule(),
+					rules.NewModuleUnknownOutputRule(),
+					rules.NewNoHardcodedAccountIDRule(),
+					rules.NewModuleDirectoryExistsRule(),
+					rules.NewModuleReservedNameRule(),
+					rules.NewModuleMaxDependenciesRule(),
+					rules.NewRequiredProvidersDeclaredRule(),
+					rules.NewRequiredTerraformVersionRule(),
+					rules.NewModuleCountForEachConflictRule(),
+					rules.NewModuleReachabilityRule(),
+					rules.NewStatefulResourcePreventDestroyRule(),
+					rules.NewResourceRequiredTagsRule(),
+					rules.NewDuplicateResourceRule(),
+					rules.NewVariableTypeRequiredRule(),
+					rules.NewVariableDescriptionRequiredRule(),
+					rules.NewOutputDescriptionRequiredRule(),
+					rules.NewSensitiveOutputRule(),
+					rules.NewResourceNamePrefixRule(),
+					rules.NewLocalNamingConventionRule(),
+					rules.NewUnusedLocalRule(),
+					rules.NewUnusedVariableRule(),
+					rules.NewModuleProviderVersionRule(),
+					rules.NewPreferForEachRule(),
+					rules.NewProviderAliasDefinedRule(),
+					rules.NewBackendConfiguredRule(),
+					rules.NewRedundantDataSourceRule(),
+					rules.NewEmptyModuleBlockRule(),
+					rules.NewModuleConsistentVersionRule(),
+					rules.NewNoHardcodedRegionRule(),
+					rules.NewModuleReadmeRule(),
+					rules.NewMaxResourcesPerFileRule(),
+					rules.NewProviderVersionConstraintRule(),
+					rules.NewModuleReferenceExistsRule(),
+					rules.NewSensitiveVariableRule(),
+					rules.NewVariableDefaultPolicyRule(),
+					rules.NewLifecycleIgnoreAllRule(),
+					rules.NewModuleNestingDepthRule(),
+					rules.NewModuleOutputNamingRule(),
+					rules.NewForEachModuleReferenceRule(),
+					rules.NewProviderBlockOrderingRule(),
+					rules.NewAmiHardcodedRule(),
+					rules.NewResourceExplicitProviderRule(),
+					rules.NewIamWildcardPolicyRule(),
+					rules.NewModuleGitRefTagRule(),
+					rules.NewProviderAliasConsistencyRule(),
+					rules.NewDeprecatedInterpolationRule(),
+					rules.NewModuleArgumentVariableExistsRule(),
+					rules.NewMaxModulesPerFileRule(),
+					rules.NewModuleNameMatchesSourceRule(),
+					rules.NewResourceModuleOutputExistsRule(),
+					rules.NewProviderRegionLiteralRule(),
+					rules.NewModuleForEachMapRule(),
+					rules.NewDataSourceUnfilteredRule(),
+					rules.NewModuleArgumentTypeRule(),
+					rules.NewCountComputedRule(),
+					rules.NewDependsOnReasonRule(),
+					rules.NewProviderSourceRequiredRule(),
+					rules.NewNoLocalExecRule(),
+					rules.NewModuleTagsPropagationRule(),
+					rules.NewProviderAliasNamingRule(),
+				},
 			},
 		},
 	})
@@ -0,0 +1,112 @@
+package rules
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// amiPattern matches AMI IDs (e.g. ami-0123456789abcdef0)
+var amiPattern = regexp.MustCompile(`\bami-[0-9a-f]{8}(?:[0-9a-f]{9})?\b`)
+
+// amiAttributes are the attributes holding an AMI ID for each resource type
+var amiAttributes = map[string]string{
+	"aws_instance":             "ami",
+	"aws_launch_template":      "image_id",
+	"aws_launch_configuration": "image_id",
+}
+
+// AmiHardcodedRule checks for AMI IDs hardcoded in instances and launch templates
+type AmiHardcodedRule struct {
+	tflint.DefaultRule
+	docsLink
+}
+
+// amiHardcodedRuleConfig is the configuration for the rule
+type amiHardcodedRuleConfig struct {
+	Allow []string `hclext:"allow,optional"`
+}
+
+// NewAmiHardcodedRule creates a new rule instance
+func NewAmiHardcodedRule() *AmiHardcodedRule {
+	return &AmiHardcodedRule{}
+}
+
+// Name returns the rule name
+func (r *AmiHardcodedRule) Name() string {
+	return "ami_hardcoded"
+}
+
+// Enabled returns whether the rule is enabled
+func (r *AmiHardcodedRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *AmiHardcodedRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns a link to detailed information about the rule
+func (r *AmiHardcodedRule) Link() string {
+	return r.link(r.Name())
+}
+
+// Check executes the rule checking process
+func (r *AmiHardcodedRule) Check(runner tflint.Runner) error {
+	// Decode rule configuration
+	config := amiHardcodedRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	allowed := make(map[string]bool)
+	for _, ami := range config.Allow {
+		allowed[ami] = true
+	}
+
+	files, fileNames, err := sortedFiles(runner)
+	if err != nil {
+		return err
+	}
+
+	for _, fileName := range fileNames {
+		body, ok := files[fileName].Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		for _, block := range body.Blocks {
+			if block.Type != "resource" || len(block.Labels) < 2 {
+				continue
+			}
+			attrName, exists := amiAttributes[block.Labels[0]]
+			if !exists {
+				continue
+			}
+			attr, exists := block.Body.Attributes[attrName]
+			if !exists {
+				continue
+			}
+
+			for _, lit := range literalStrings(attr.Expr) {
+				ami := amiPattern.FindString(lit.Val.AsString())
+				if ami == "" || allowed[ami] {
+					continue
+				}
+
+				err := runner.EmitIssue(
+					r,
+					"Hardcoded AMI ID; prefer a data \"aws_ami\" lookup",
+					lit.Range(),
+				)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,105 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestAmiHardcodedRule(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		config   string
+		expected helper.Issues
+	}{
+		{
+			name: "literal AMI",
+			content: `
+resource "aws_instance" "web" {
+  ami = "ami-0123456789abcdef0"
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewAmiHardcodedRule(),
+					Message: "Hardcoded AMI ID; prefer a data \"aws_ami\" lookup",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 10},
+						End:      hcl.Pos{Line: 3, Column: 31},
+					},
+				},
+			},
+		},
+		{
+			name: "literal AMI in launch template",
+			content: `
+resource "aws_launch_template" "web" {
+  image_id = "ami-0123456789abcdef0"
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewAmiHardcodedRule(),
+					Message: "Hardcoded AMI ID; prefer a data \"aws_ami\" lookup",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 15},
+						End:      hcl.Pos{Line: 3, Column: 36},
+					},
+				},
+			},
+		},
+		{
+			name: "data source referenced AMI",
+			content: `
+data "aws_ami" "ubuntu" {
+  most_recent = true
+  owners      = ["099720109477"]
+}
+
+resource "aws_instance" "web" {
+  ami = data.aws_ami.ubuntu.id
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "variable referenced AMI",
+			content: `
+resource "aws_instance" "web" {
+  ami = var.ami_id
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "allowed golden image",
+			content: `
+resource "aws_instance" "web" {
+  ami = "ami-0123456789abcdef0"
+}`,
+			config: `
+rule "ami_hardcoded" {
+  enabled = true
+  allow   = ["ami-0123456789abcdef0"]
+}`,
+			expected: helper.Issues{},
+		},
+	}
+
+	rule := NewAmiHardcodedRule()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			files := map[string]string{"main.tf": test.content}
+			if test.config != "" {
+				files[".tflint.hcl"] = test.config
+			}
+			runner := helper.TestRunner(t, files)
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.expected, runner.Issues)
+		})
+	}
+}
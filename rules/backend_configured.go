@@ -0,0 +1,124 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// BackendConfiguredRule checks that a backend is configured and of an approved type
+type BackendConfiguredRule struct {
+	tflint.DefaultRule
+	docsLink
+}
+
+// backendConfiguredRuleConfig is the configuration for the rule
+type backendConfiguredRuleConfig struct {
+	AllowedBackends []string `hclext:"allowed_backends,optional"`
+}
+
+// NewBackendConfiguredRule creates a new rule instance
+func NewBackendConfiguredRule() *BackendConfiguredRule {
+	return &BackendConfiguredRule{}
+}
+
+// Name returns the rule name
+func (r *BackendConfiguredRule) Name() string {
+	return "backend_configured"
+}
+
+// Enabled returns whether the rule is enabled
+func (r *BackendConfiguredRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *BackendConfiguredRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns a link to detailed information about the rule
+func (r *BackendConfiguredRule) Link() string {
+	return r.link(r.Name())
+}
+
+// Check executes the rule checking process
+func (r *BackendConfiguredRule) Check(runner tflint.Runner) error {
+	// Decode rule configuration
+	config := backendConfiguredRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	allowed := make(map[string]bool)
+	for _, backend := range config.AllowedBackends {
+		allowed[backend] = true
+	}
+
+	files, fileNames, err := sortedFiles(runner)
+	if err != nil {
+		return err
+	}
+	if len(fileNames) == 0 {
+		return nil
+	}
+
+	// Point at the first terraform block, or the top of the first file when there is none
+	missingRange := hcl.Range{Filename: fileNames[0], Start: hcl.InitialPos, End: hcl.InitialPos}
+	foundBlock := false
+	var backends []*hclsyntax.Block
+
+	for _, fileName := range fileNames {
+		body, ok := files[fileName].Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		for _, block := range body.Blocks {
+			if block.Type != "terraform" {
+				continue
+			}
+			if !foundBlock {
+				foundBlock = true
+				missingRange = block.DefRange()
+			}
+			for _, inner := range block.Body.Blocks {
+				if inner.Type == "backend" && len(inner.Labels) > 0 {
+					backends = append(backends, inner)
+				}
+			}
+		}
+	}
+
+	if len(backends) == 0 {
+		return runner.EmitIssue(
+			r,
+			"No backend block configured in terraform block",
+			missingRange,
+		)
+	}
+
+	if len(allowed) == 0 {
+		return nil
+	}
+
+	for _, backend := range backends {
+		if allowed[backend.Labels[0]] {
+			continue
+		}
+
+		err := runner.EmitIssue(
+			r,
+			fmt.Sprintf("Backend \"%s\" is not allowed; use one of %s", backend.Labels[0], strings.Join(config.AllowedBackends, ", ")),
+			backend.DefRange(),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestBackendConfiguredRule(t *testing.T) {
+	config := `
+rule "backend_configured" {
+  enabled = true
+  allowed_backends = ["s3", "gcs"]
+}`
+
+	tests := []struct {
+		name     string
+		content  string
+		config   string
+		expected helper.Issues
+	}{
+		{
+			name: "missing backend",
+			content: `
+terraform {
+  required_version = "~> 1.5"
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewBackendConfiguredRule(),
+					Message: "No backend block configured in terraform block",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 10},
+					},
+				},
+			},
+		},
+		{
+			name: "allowed backend",
+			content: `
+terraform {
+  backend "s3" {
+    bucket = "state"
+  }
+}`,
+			config:   config,
+			expected: helper.Issues{},
+		},
+		{
+			name: "disallowed backend",
+			content: `
+terraform {
+  backend "local" {}
+}`,
+			config: config,
+			expected: helper.Issues{
+				{
+					Rule:    NewBackendConfiguredRule(),
+					Message: "Backend \"local\" is not allowed; use one of s3, gcs",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 18},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewBackendConfiguredRule()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			files := map[string]string{"main.tf": test.content}
+			if test.config != "" {
+				files[".tflint.hcl"] = test.config
+			}
+			runner := helper.TestRunner(t, files)
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package rules
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// CountComputedRule checks that resource count and for_each do not depend on values known only after apply
+type CountComputedRule struct {
+	tflint.DefaultRule
+	docsLink
+}
+
+// NewCountComputedRule creates a new rule instance
+func NewCountComputedRule() *CountComputedRule {
+	return &CountComputedRule{}
+}
+
+// Name returns the rule name
+func (r *CountComputedRule) Name() string {
+	return "count_computed"
+}
+
+// Enabled returns whether the rule is enabled
+func (r *CountComputedRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *CountComputedRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns a link to detailed information about the rule
+func (r *CountComputedRule) Link() string {
+	return r.link(r.Name())
+}
+
+// Check executes the rule checking process
+func (r *CountComputedRule) Check(runner tflint.Runner) error {
+	files, fileNames, err := sortedFiles(runner)
+	if err != nil {
+		return err
+	}
+
+	// Collect declared resource types, which are the root names of resource references
+	typeSet := make(map[string]bool)
+	for _, fileName := range fileNames {
+		body, ok := files[fileName].Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+		for _, block := range body.Blocks {
+			if block.Type == "resource" && len(block.Labels) > 0 {
+				typeSet[block.Labels[0]] = true
+			}
+		}
+	}
+	resourceTypes := make([]string, 0, len(typeSet))
+	for t := range typeSet {
+		resourceTypes = append(resourceTypes, t)
+	}
+	sort.Strings(resourceTypes)
+
+	for _, fileName := range fileNames {
+		body, ok := files[fileName].Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		for _, block := range body.Blocks {
+			if block.Type != "resource" || len(block.Labels) < 2 {
+				continue
+			}
+
+			for _, name := range []string{"count", "for_each"} {
+				attr, exists := block.Body.Attributes[name]
+				if !exists {
+					continue
+				}
+				traversal, ok := r.computedReference(attr.Expr, resourceTypes)
+				if !ok {
+					continue
+				}
+
+				err := runner.EmitIssue(
+					r,
+					fmt.Sprintf("%s for \"%s.%s\" depends on a computed value; prefer a variable", name, block.Labels[0], block.Labels[1]),
+					traversal.SourceRange(),
+				)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// computedReference returns the first reference to a resource attribute or module output in an expression
+// References to a whole resource or module (e.g. length(aws_subnet.private)) are not reported
+func (r *CountComputedRule) computedReference(expr hcl.Expression, resourceTypes []string) (hcl.Traversal, bool) {
+	for _, root := range append([]string{"module"}, resourceTypes...) {
+		for _, traversal := range collectTraversals(expr, root) {
+			if len(traversal) >= 3 {
+				return traversal, true
+			}
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,90 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestCountComputedRule(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected helper.Issues
+	}{
+		{
+			name: "literal count",
+			content: `
+resource "aws_instance" "web" {
+  count = 2
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "variable count",
+			content: `
+variable "instance_count" {
+  type = number
+}
+
+resource "aws_instance" "web" {
+  count = var.instance_count
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "resource attribute count",
+			content: `
+resource "aws_subnet" "private" {
+  count = 2
+}
+
+resource "aws_instance" "web" {
+  count = length(aws_subnet.private[0].ipv6_cidr_block)
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewCountComputedRule(),
+					Message: "count for \"aws_instance.web\" depends on a computed value; prefer a variable",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 7, Column: 18},
+						End:      hcl.Pos{Line: 7, Column: 55},
+					},
+				},
+			},
+		},
+		{
+			name: "module output for_each",
+			content: `
+resource "aws_instance" "web" {
+  for_each = module.network.subnet_ids
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewCountComputedRule(),
+					Message: "for_each for \"aws_instance.web\" depends on a computed value; prefer a variable",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 14},
+						End:      hcl.Pos{Line: 3, Column: 39},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewCountComputedRule()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": test.content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.expected, runner.Issues)
+		})
+	}
+}
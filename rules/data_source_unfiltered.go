@@ -0,0 +1,109 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// defaultDataSourceFilters are the filter arguments checked when filters is not configured
+var defaultDataSourceFilters = map[string][]string{
+	"aws_ami": {"filter", "owners"},
+}
+
+// DataSourceUnfilteredRule checks that data sources set at least one of their filter arguments
+type DataSourceUnfilteredRule struct {
+	tflint.DefaultRule
+	docsLink
+}
+
+// dataSourceUnfilteredRuleConfig is the configuration for the rule
+type dataSourceUnfilteredRuleConfig struct {
+	Filters map[string][]string `hclext:"filters,optional"`
+}
+
+// NewDataSourceUnfilteredRule creates a new rule instance
+func NewDataSourceUnfilteredRule() *DataSourceUnfilteredRule {
+	return &DataSourceUnfilteredRule{}
+}
+
+// Name returns the rule name
+func (r *DataSourceUnfilteredRule) Name() string {
+	return "data_source_unfiltered"
+}
+
+// Enabled returns whether the rule is enabled
+func (r *DataSourceUnfilteredRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *DataSourceUnfilteredRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns a link to detailed information about the rule
+func (r *DataSourceUnfilteredRule) Link() string {
+	return r.link(r.Name())
+}
+
+// Check executes the rule checking process
+func (r *DataSourceUnfilteredRule) Check(runner tflint.Runner) error {
+	// Decode rule configuration
+	config := dataSourceUnfilteredRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+	if len(config.Filters) == 0 {
+		config.Filters = defaultDataSourceFilters
+	}
+
+	files, fileNames, err := sortedFiles(runner)
+	if err != nil {
+		return err
+	}
+
+	for _, fileName := range fileNames {
+		body, ok := files[fileName].Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		for _, block := range body.Blocks {
+			if block.Type != "data" || len(block.Labels) < 2 {
+				continue
+			}
+			filters, exists := config.Filters[block.Labels[0]]
+			if !exists || r.hasAnyFilter(block, filters) {
+				continue
+			}
+
+			err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("Data source \"%s.%s\" has no filters and may be ambiguous", block.Labels[0], block.Labels[1]),
+				block.DefRange(),
+			)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// hasAnyFilter returns whether the data source sets any of the filters as an attribute or a nested block
+func (r *DataSourceUnfilteredRule) hasAnyFilter(block *hclsyntax.Block, filters []string) bool {
+	for _, name := range filters {
+		if _, exists := block.Body.Attributes[name]; exists {
+			return true
+		}
+		for _, inner := range block.Body.Blocks {
+			if inner.Type == name {
+				return true
+			}
+		}
+	}
+	return false
+}
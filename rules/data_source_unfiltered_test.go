@@ -0,0 +1,98 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestDataSourceUnfilteredRule(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		config   string
+		expected helper.Issues
+	}{
+		{
+			name: "filtered data source",
+			content: `
+data "aws_ami" "ubuntu" {
+  most_recent = true
+
+  filter {
+    name   = "name"
+    values = ["ubuntu/images/*"]
+  }
+}
+
+data "aws_ami" "amazon" {
+  most_recent = true
+  owners      = ["amazon"]
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "unfiltered data source",
+			content: `
+data "aws_ami" "latest" {
+  most_recent = true
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewDataSourceUnfilteredRule(),
+					Message: "Data source \"aws_ami.latest\" has no filters and may be ambiguous",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 24},
+					},
+				},
+			},
+		},
+		{
+			name: "custom filters",
+			content: `
+data "aws_vpc" "main" {}
+
+data "aws_ami" "latest" {
+  most_recent = true
+}`,
+			config: `
+rule "data_source_unfiltered" {
+  enabled = true
+  filters = {
+    aws_vpc = ["id", "tags", "filter"]
+  }
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewDataSourceUnfilteredRule(),
+					Message: "Data source \"aws_vpc.main\" has no filters and may be ambiguous",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 22},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewDataSourceUnfilteredRule()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			files := map[string]string{"main.tf": test.content}
+			if test.config != "" {
+				files[".tflint.hcl"] = test.config
+			}
+			runner := helper.TestRunner(t, files)
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,135 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// DependsOnReasonRule checks that depends_on is accompanied by a comment explaining the hidden dependency
+type DependsOnReasonRule struct {
+	tflint.DefaultRule
+	docsLink
+}
+
+// NewDependsOnReasonRule creates a new rule instance
+func NewDependsOnReasonRule() *DependsOnReasonRule {
+	return &DependsOnReasonRule{}
+}
+
+// Name returns the rule name
+func (r *DependsOnReasonRule) Name() string {
+	return "depends_on_reason"
+}
+
+// Enabled returns whether the rule is enabled
+func (r *DependsOnReasonRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *DependsOnReasonRule) Severity() tflint.Severity {
+	return tflint.NOTICE
+}
+
+// Link returns a link to detailed information about the rule
+func (r *DependsOnReasonRule) Link() string {
+	return r.link(r.Name())
+}
+
+// Check executes the rule checking process
+func (r *DependsOnReasonRule) Check(runner tflint.Runner) error {
+	files, fileNames, err := sortedFiles(runner)
+	if err != nil {
+		return err
+	}
+
+	for _, fileName := range fileNames {
+		file := files[fileName]
+		body, ok := file.Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		var standalone, commented map[int]bool
+		for _, block := range body.Blocks {
+			var address string
+			switch {
+			case block.Type == "resource" && len(block.Labels) >= 2:
+				address = fmt.Sprintf("%s.%s", block.Labels[0], block.Labels[1])
+			case block.Type == "module" && len(block.Labels) > 0:
+				address = "module." + block.Labels[0]
+			default:
+				continue
+			}
+
+			attr, exists := block.Body.Attributes["depends_on"]
+			if !exists {
+				continue
+			}
+
+			// Comments are not part of the syntax tree, so they are read from the token stream once per file
+			if commented == nil {
+				standalone, commented = r.commentLines(file, fileName)
+			}
+
+			// Accept a comment taking up the whole line above, or a comment on the depends_on lines
+			// A trailing comment on the line above belongs to the previous argument and is not enough
+			rng := attr.Range()
+			explained := standalone[rng.Start.Line-1]
+			for line := rng.Start.Line; line <= rng.End.Line && !explained; line++ {
+				explained = commented[line]
+			}
+			if explained {
+				continue
+			}
+
+			err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("depends_on on \"%s\" should have an explanatory comment", address),
+				rng,
+			)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// commentLines returns the lines of a file taken up entirely by a comment, and the lines containing any comment
+func (r *DependsOnReasonRule) commentLines(file *hcl.File, fileName string) (map[int]bool, map[int]bool) {
+	standalone := make(map[int]bool)
+	lines := make(map[int]bool)
+	tokens, _ := hclsyntax.LexConfig(file.Bytes, fileName, hcl.InitialPos)
+	for i, token := range tokens {
+		if token.Type != hclsyntax.TokenComment {
+			continue
+		}
+
+		// A comment is on its own line when no other token precedes it on that line
+		ownLine := i == 0 || tokens[i-1].Range.End.Line < token.Range.Start.Line || r.endsLine(tokens[i-1])
+
+		// Line comments include their trailing newline, which ends the range on the next line
+		end := token.Range.End.Line
+		if strings.HasSuffix(string(token.Bytes), "\n") {
+			end--
+		}
+		for line := token.Range.Start.Line; line <= end; line++ {
+			lines[line] = true
+			if ownLine {
+				standalone[line] = true
+			}
+		}
+	}
+	return standalone, lines
+}
+
+// endsLine returns whether a token finishes its line, so that the next token starts a new one
+func (r *DependsOnReasonRule) endsLine(token hclsyntax.Token) bool {
+	return token.Type == hclsyntax.TokenNewline || strings.HasSuffix(string(token.Bytes), "\n")
+}
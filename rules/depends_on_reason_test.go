@@ -0,0 +1,87 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestDependsOnReasonRule(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected helper.Issues
+	}{
+		{
+			name: "leading comment",
+			content: `
+resource "aws_instance" "web" {
+  # The instance profile must exist before the instance boots
+  depends_on = [aws_iam_role_policy.web]
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "trailing comment",
+			content: `
+module "app" {
+  source     = "./modules/app"
+  depends_on = [module.network] // routes are created outside of the module outputs
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "uncommented depends_on",
+			content: `
+resource "aws_instance" "web" {
+  ami = "ami-12345678"
+
+  depends_on = [aws_iam_role_policy.web]
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewDependsOnReasonRule(),
+					Message: "depends_on on \"aws_instance.web\" should have an explanatory comment",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 5, Column: 3},
+						End:      hcl.Pos{Line: 5, Column: 41},
+					},
+				},
+			},
+		},
+		{
+			name: "trailing comment on previous attribute",
+			content: `
+resource "aws_instance" "web" {
+  count      = 1 # one instance
+  depends_on = [aws_iam_role_policy.web]
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewDependsOnReasonRule(),
+					Message: "depends_on on \"aws_instance.web\" should have an explanatory comment",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 4, Column: 3},
+						End:      hcl.Pos{Line: 4, Column: 41},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewDependsOnReasonRule()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": test.content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// DeprecatedInterpolationRule checks for strings wrapping a single reference in an interpolation
+type DeprecatedInterpolationRule struct {
+	tflint.DefaultRule
+	docsLink
+}
+
+// NewDeprecatedInterpolationRule creates a new rule instance
+func NewDeprecatedInterpolationRule() *DeprecatedInterpolationRule {
+	return &DeprecatedInterpolationRule{}
+}
+
+// Name returns the rule name
+func (r *DeprecatedInterpolationRule) Name() string {
+	return "deprecated_interpolation"
+}
+
+// Enabled returns whether the rule is enabled
+func (r *DeprecatedInterpolationRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *DeprecatedInterpolationRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns a link to detailed information about the rule
+func (r *DeprecatedInterpolationRule) Link() string {
+	return r.link(r.Name())
+}
+
+// Check executes the rule checking process
+func (r *DeprecatedInterpolationRule) Check(runner tflint.Runner) error {
+	files, fileNames, err := sortedFiles(runner)
+	if err != nil {
+		return err
+	}
+
+	for _, fileName := range fileNames {
+		file := files[fileName]
+		body, ok := file.Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		var emitErr error
+		walkAttributes(body, func(attr *hclsyntax.Attribute) {
+			if emitErr != nil {
+				return
+			}
+			traversal, ok := r.interpolationOnly(attr.Expr)
+			if !ok {
+				return
+			}
+
+			emitErr = runner.EmitIssue(
+				r,
+				fmt.Sprintf("Unnecessary interpolation-only string; use %s directly", traversal.Range().SliceBytes(file.Bytes)),
+				attr.Expr.Range(),
+			)
+		})
+		if emitErr != nil {
+			return emitErr
+		}
+	}
+
+	return nil
+}
+
+// interpolationOnly returns the reference wrapped by a string with no surrounding text, such as "${module.foo.out}"
+func (r *DeprecatedInterpolationRule) interpolationOnly(expr hclsyntax.Expression) (*hclsyntax.ScopeTraversalExpr, bool) {
+	var wrapped hclsyntax.Expression
+	switch e := expr.(type) {
+	case *hclsyntax.TemplateWrapExpr:
+		wrapped = e.Wrapped
+	case *hclsyntax.TemplateExpr:
+		if len(e.Parts) != 1 {
+			return nil, false
+		}
+		wrapped = e.Parts[0]
+	default:
+		return nil, false
+	}
+
+	traversal, ok := wrapped.(*hclsyntax.ScopeTraversalExpr)
+	return traversal, ok
+}
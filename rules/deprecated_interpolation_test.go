@@ -0,0 +1,67 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestDeprecatedInterpolationRule(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected helper.Issues
+	}{
+		{
+			name: "interpolation-only string",
+			content: `
+module "app" {
+  source = "./modules/app"
+  vpc_id = "${module.network.vpc_id}"
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewDeprecatedInterpolationRule(),
+					Message: "Unnecessary interpolation-only string; use module.network.vpc_id directly",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 4, Column: 12},
+						End:      hcl.Pos{Line: 4, Column: 38},
+					},
+				},
+			},
+		},
+		{
+			name: "mixed template",
+			content: `
+module "app" {
+  source = "./modules/app"
+  name   = "${var.environment}-app"
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "bare reference",
+			content: `
+module "app" {
+  source = "./modules/app"
+  vpc_id = module.network.vpc_id
+}`,
+			expected: helper.Issues{},
+		},
+	}
+
+	rule := NewDeprecatedInterpolationRule()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": test.content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// DuplicateResourceRule checks that no resource address is defined more than once
+type DuplicateResourceRule struct {
+	tflint.DefaultRule
+	docsLink
+}
+
+// NewDuplicateResourceRule creates a new rule instance
+func NewDuplicateResourceRule() *DuplicateResourceRule {
+	return &DuplicateResourceRule{}
+}
+
+// Name returns the rule name
+func (r *DuplicateResourceRule) Name() string {
+	return "duplicate_resource"
+}
+
+// Enabled returns whether the rule is enabled
+func (r *DuplicateResourceRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *DuplicateResourceRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns a link to detailed information about the rule
+func (r *DuplicateResourceRule) Link() string {
+	return r.link(r.Name())
+}
+
+// Check executes the rule checking process
+func (r *DuplicateResourceRule) Check(runner tflint.Runner) error {
+	files, fileNames, err := sortedFiles(runner)
+	if err != nil {
+		return err
+	}
+
+	firstDefined := make(map[string]hcl.Range) // Range of the first definition of each address
+	reported := make(map[string]bool)          // Addresses whose first definition has been reported
+
+	for _, fileName := range fileNames {
+		body, ok := files[fileName].Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		for _, block := range body.Blocks {
+			if block.Type != "resource" || len(block.Labels) < 2 {
+				continue
+			}
+
+			address := block.Labels[0] + "." + block.Labels[1]
+			first, exists := firstDefined[address]
+			if !exists {
+				firstDefined[address] = block.DefRange()
+				continue
+			}
+
+			message := fmt.Sprintf("Duplicate resource address \"%s\" defined in %s and %s", address, first.Filename, block.DefRange().Filename)
+
+			// Point at both definitions, reporting the first one only once
+			if !reported[address] {
+				reported[address] = true
+				if err := runner.EmitIssue(r, message, first); err != nil {
+					return err
+				}
+			}
+			if err := runner.EmitIssue(r, message, block.DefRange()); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
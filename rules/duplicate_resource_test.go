@@ -0,0 +1,77 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestDuplicateResourceRule(t *testing.T) {
+	tests := []struct {
+		name     string
+		files    map[string]string
+		expected helper.Issues
+	}{
+		{
+			name: "unique addresses",
+			files: map[string]string{
+				"a.tf": `
+resource "aws_instance" "web" {
+  ami = "ami-123456"
+}`,
+				"b.tf": `
+resource "aws_instance" "api" {
+  ami = "ami-123456"
+}`,
+			},
+			expected: helper.Issues{},
+		},
+		{
+			name: "duplicate address across files",
+			files: map[string]string{
+				"a.tf": `
+resource "aws_instance" "web" {
+  ami = "ami-123456"
+}`,
+				"b.tf": `
+resource "aws_instance" "web" {
+  ami = "ami-654321"
+}`,
+			},
+			expected: helper.Issues{
+				{
+					Rule:    NewDuplicateResourceRule(),
+					Message: "Duplicate resource address \"aws_instance.web\" defined in a.tf and b.tf",
+					Range: hcl.Range{
+						Filename: "a.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 30},
+					},
+				},
+				{
+					Rule:    NewDuplicateResourceRule(),
+					Message: "Duplicate resource address \"aws_instance.web\" defined in a.tf and b.tf",
+					Range: hcl.Range{
+						Filename: "b.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 30},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewDuplicateResourceRule()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, test.files)
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// moduleMetaArguments are module block arguments that are not inputs to the module
+var moduleMetaArguments = map[string]bool{
+	"source":     true,
+	"version":    true,
+	"count":      true,
+	"for_each":   true,
+	"providers":  true,
+	"depends_on": true,
+}
+
+// EmptyModuleBlockRule checks for module blocks that pass no inputs
+type EmptyModuleBlockRule struct {
+	tflint.DefaultRule
+	docsLink
+}
+
+// emptyModuleBlockRuleConfig is the configuration for the rule
+type emptyModuleBlockRuleConfig struct {
+	Ignore []string `hclext:"ignore,optional"`
+}
+
+// NewEmptyModuleBlockRule creates a new rule instance
+func NewEmptyModuleBlockRule() *EmptyModuleBlockRule {
+	return &EmptyModuleBlockRule{}
+}
+
+// Name returns the rule name
+func (r *EmptyModuleBlockRule) Name() string {
+	return "empty_module_block"
+}
+
+// Enabled returns whether the rule is enabled
+func (r *EmptyModuleBlockRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *EmptyModuleBlockRule) Severity() tflint.Severity {
+	return tflint.NOTICE
+}
+
+// Link returns a link to detailed information about the rule
+func (r *EmptyModuleBlockRule) Link() string {
+	return r.link(r.Name())
+}
+
+// Check executes the rule checking process
+func (r *EmptyModuleBlockRule) Check(runner tflint.Runner) error {
+	// Decode rule configuration
+	config := emptyModuleBlockRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	ignored := make(map[string]bool)
+	for _, name := range config.Ignore {
+		ignored[name] = true
+	}
+
+	files, fileNames, err := sortedFiles(runner)
+	if err != nil {
+		return err
+	}
+
+	for _, fileName := range fileNames {
+		body, ok := files[fileName].Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		for _, block := range body.Blocks {
+			if block.Type != "module" || len(block.Labels) == 0 || ignored[block.Labels[0]] {
+				continue
+			}
+
+			hasInput := false
+			for name := range block.Body.Attributes {
+				if !moduleMetaArguments[name] {
+					hasInput = true
+					break
+				}
+			}
+			if hasInput {
+				continue
+			}
+
+			err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("Module \"%s\" has no arguments other than source", block.Labels[0]),
+				block.DefRange(),
+			)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestEmptyModuleBlockRule(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		config   string
+		expected helper.Issues
+	}{
+		{
+			name: "module without inputs",
+			content: `
+module "vpc" {
+  source  = "terraform-aws-modules/vpc/aws"
+  version = "5.1.0"
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewEmptyModuleBlockRule(),
+					Message: "Module \"vpc\" has no arguments other than source",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 13},
+					},
+				},
+			},
+		},
+		{
+			name: "module with inputs",
+			content: `
+module "vpc" {
+  source  = "terraform-aws-modules/vpc/aws"
+  version = "5.1.0"
+  cidr    = "10.0.0.0/16"
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "ignored module",
+			content: `
+module "labels" {
+  source = "./modules/labels"
+}`,
+			config: `
+rule "empty_module_block" {
+  enabled = true
+  ignore = ["labels"]
+}`,
+			expected: helper.Issues{},
+		},
+	}
+
+	rule := NewEmptyModuleBlockRule()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			files := map[string]string{"main.tf": test.content}
+			if test.config != "" {
+				files[".tflint.hcl"] = test.config
+			}
+			runner := helper.TestRunner(t, files)
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// ForEachModuleReferenceRule checks that references to for_each modules include an instance key
+type ForEachModuleReferenceRule struct {
+	tflint.DefaultRule
+	docsLink
+}
+
+// NewForEachModuleReferenceRule creates a new rule instance
+func NewForEachModuleReferenceRule() *ForEachModuleReferenceRule {
+	return &ForEachModuleReferenceRule{}
+}
+
+// Name returns the rule name
+func (r *ForEachModuleReferenceRule) Name() string {
+	return "for_each_module_reference"
+}
+
+// Enabled returns whether the rule is enabled
+func (r *ForEachModuleReferenceRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *ForEachModuleReferenceRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns a link to detailed information about the rule
+func (r *ForEachModuleReferenceRule) Link() string {
+	return r.link(r.Name())
+}
+
+// Check executes the rule checking process
+func (r *ForEachModuleReferenceRule) Check(runner tflint.Runner) error {
+	files, fileNames, err := sortedFiles(runner)
+	if err != nil {
+		return err
+	}
+
+	// Collect the modules declared with for_each
+	forEachModules := make(map[string]bool)
+	for _, fileName := range fileNames {
+		body, ok := files[fileName].Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+		for _, block := range body.Blocks {
+			if block.Type != "module" || len(block.Labels) == 0 {
+				continue
+			}
+			if _, exists := block.Body.Attributes["for_each"]; exists {
+				forEachModules[block.Labels[0]] = true
+			}
+		}
+	}
+
+	for _, fileName := range fileNames {
+		body, ok := files[fileName].Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		var emitErr error
+		walkAttributes(body, func(attr *hclsyntax.Attribute) {
+			for _, traversal := range collectTraversals(attr.Expr, "module") {
+				if emitErr != nil {
+					return
+				}
+				name, ok := moduleNameFromTraversal(traversal)
+				if !ok || !forEachModules[name] || !r.missingInstanceKey(traversal) {
+					continue
+				}
+
+				emitErr = runner.EmitIssue(
+					r,
+					fmt.Sprintf("Module \"%s\" uses for_each; references must include an instance key", name),
+					traversal.SourceRange(),
+				)
+			}
+		})
+		if emitErr != nil {
+			return emitErr
+		}
+	}
+
+	return nil
+}
+
+// missingInstanceKey returns whether a traversal accesses an output without selecting an instance
+// References to the whole module (module.name) are valid, since they evaluate to the map of instances
+func (r *ForEachModuleReferenceRule) missingInstanceKey(traversal hcl.Traversal) bool {
+	if len(traversal) < 3 {
+		return false
+	}
+	_, isAttr := traversal[2].(hcl.TraverseAttr)
+	return isAttr
+}
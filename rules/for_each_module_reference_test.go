@@ -0,0 +1,91 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestForEachModuleReferenceRule(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected helper.Issues
+	}{
+		{
+			name: "keyed reference",
+			content: `
+module "bucket" {
+  source   = "./modules/bucket"
+  for_each = toset(["logs", "assets"])
+}
+
+output "logs_arn" {
+  value = module.bucket["logs"].arn
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "reference to all instances",
+			content: `
+module "bucket" {
+  source   = "./modules/bucket"
+  for_each = toset(["logs", "assets"])
+}
+
+output "arns" {
+  value = [for b in module.bucket : b.arn]
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "unkeyed reference",
+			content: `
+module "bucket" {
+  source   = "./modules/bucket"
+  for_each = toset(["logs", "assets"])
+}
+
+output "logs_arn" {
+  value = module.bucket.arn
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewForEachModuleReferenceRule(),
+					Message: "Module \"bucket\" uses for_each; references must include an instance key",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 8, Column: 11},
+						End:      hcl.Pos{Line: 8, Column: 28},
+					},
+				},
+			},
+		},
+		{
+			name: "unkeyed reference to module without for_each",
+			content: `
+module "bucket" {
+  source = "./modules/bucket"
+}
+
+output "logs_arn" {
+  value = module.bucket.arn
+}`,
+			expected: helper.Issues{},
+		},
+	}
+
+	rule := NewForEachModuleReferenceRule()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": test.content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,357 @@
+package rules
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclparse"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// literalString returns the value of an expression if it is a static string
+func literalString(expr hcl.Expression) (string, bool) {
+	val, diags := expr.Value(nil)
+	if diags.HasErrors() || val.IsNull() || !val.IsKnown() || !val.Type().Equals(cty.String) {
+		return "", false
+	}
+	return val.AsString(), true
+}
+
+// objectItem returns the value of the item with the given key in an object expression
+// Both bare identifier keys (version = ...) and quoted keys ("version" = ...) are matched
+func objectItem(obj *hclsyntax.ObjectConsExpr, key string) (hclsyntax.Expression, bool) {
+	for _, item := range obj.Items {
+		name := hcl.ExprAsKeyword(item.KeyExpr)
+		if name == "" {
+			name, _ = literalString(item.KeyExpr)
+		}
+		if name == key {
+			return item.ValueExpr, true
+		}
+	}
+	return nil, false
+}
+
+// localModuleDir returns the directory of a local module source (e.g. "./modules/vpc")
+// The source is resolved relative to the directory of the file declaring it
+func localModuleDir(source hcl.Expression) (string, bool) {
+	path, ok := literalString(source)
+	if !ok || !isLocalSource(path) {
+		return "", false
+	}
+	return filepath.Clean(filepath.Join(filepath.Dir(source.Range().Filename), path)), true
+}
+
+// isLocalSource returns whether a module source refers to a local path
+func isLocalSource(source string) bool {
+	return strings.HasPrefix(source, "./") || strings.HasPrefix(source, "../")
+}
+
+// registrySourcePattern matches Terraform Registry module addresses ([hostname/]namespace/name/provider[//subdir])
+var registrySourcePattern = regexp.MustCompile(`^([0-9A-Za-z-]+(\.[0-9A-Za-z-]+)+/)?[0-9A-Za-z][0-9A-Za-z-]*/[0-9A-Za-z][0-9A-Za-z_-]*/[0-9a-z]+(//.*)?$`)
+
+// isRegistrySource returns whether a module source refers to a Terraform Registry module
+func isRegistrySource(source string) bool {
+	return !isGitSource(source) && registrySourcePattern.MatchString(source)
+}
+
+// isGitSource returns whether a module source refers to a git repository
+func isGitSource(source string) bool {
+	for _, prefix := range []string{"git::", "git@", "github.com/", "bitbucket.org/"} {
+		if strings.HasPrefix(source, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// gitRef returns the ref query parameter of a git source
+func gitRef(source string) (string, bool) {
+	i := strings.Index(source, "?")
+	if i < 0 {
+		return "", false
+	}
+
+	query, err := url.ParseQuery(source[i+1:])
+	if err != nil || !query.Has("ref") {
+		return "", false
+	}
+	return query.Get("ref"), true
+}
+
+// walkAttributes calls fn for every attribute in the body, including those in nested blocks
+func walkAttributes(body *hclsyntax.Body, fn func(attr *hclsyntax.Attribute)) {
+	// Sort attributes by position for deterministic order
+	var attrs []*hclsyntax.Attribute
+	for _, attr := range body.Attributes {
+		attrs = append(attrs, attr)
+	}
+	sort.Slice(attrs, func(i, j int) bool {
+		return attrs[i].Range().Start.Line < attrs[j].Range().Start.Line
+	})
+
+	for _, attr := range attrs {
+		fn(attr)
+	}
+	for _, block := range body.Blocks {
+		walkAttributes(block.Body, fn)
+	}
+}
+
+// sortedFiles returns all files along with their names sorted for deterministic order
+func sortedFiles(runner tflint.Runner) (map[string]*hcl.File, []string, error) {
+	files, err := runner.GetFiles()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	fileNames := make([]string, 0, len(files))
+	for fileName := range files {
+		fileNames = append(fileNames, fileName)
+	}
+	sort.Strings(fileNames)
+
+	return files, fileNames, nil
+}
+
+// isTerraformFile returns whether a file name is a Terraform configuration file in native or JSON syntax
+// Both parseModuleDir and module_directory_exists rely on it, so they agree on what makes up a module
+func isTerraformFile(name string) bool {
+	return strings.HasSuffix(name, ".tf") || strings.HasSuffix(name, ".tf.json")
+}
+
+// parseModuleDir parses the Terraform files (.tf and .tf.json) in a local module directory
+// Consumers read the files through schemas, since JSON files have no hclsyntax body
+// It returns a nil map if the directory does not exist
+func parseModuleDir(dir string) (map[string]*hcl.File, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	parser := hclparse.NewParser()
+	files := make(map[string]*hcl.File)
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+
+		if !isTerraformFile(entry.Name()) {
+			continue
+		}
+		parse := parser.ParseHCL
+		if strings.HasSuffix(entry.Name(), ".tf.json") {
+			parse = parser.ParseJSON
+		}
+
+		path := filepath.Join(dir, entry.Name())
+		src, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		file, diags := parse(src, path)
+		if diags.HasErrors() {
+			return nil, diags
+		}
+		files[path] = file
+	}
+
+	return files, nil
+}
+
+// literalStrings returns the string literals within an expression, including literal parts of templates
+func literalStrings(expr hclsyntax.Expression) []*hclsyntax.LiteralValueExpr {
+	var literals []*hclsyntax.LiteralValueExpr
+	hclsyntax.VisitAll(expr, func(node hclsyntax.Node) hcl.Diagnostics {
+		if lit, ok := node.(*hclsyntax.LiteralValueExpr); ok && lit.Val.Type().Equals(cty.String) && !lit.Val.IsNull() {
+			literals = append(literals, lit)
+		}
+		return nil
+	})
+	return literals
+}
+
+// excludeFiles returns the filenames that do not match any of the glob patterns
+func excludeFiles(fileNames []string, patterns []string) ([]string, error) {
+	if len(patterns) == 0 {
+		return fileNames, nil
+	}
+
+	var kept []string
+	for _, fileName := range fileNames {
+		excluded := false
+		for _, pattern := range patterns {
+			matched, err := filepath.Match(pattern, fileName)
+			if err != nil {
+				return nil, fmt.Errorf("invalid exclude pattern %q: %w", pattern, err)
+			}
+			if matched {
+				excluded = true
+				break
+			}
+		}
+		if !excluded {
+			kept = append(kept, fileName)
+		}
+	}
+
+	return kept, nil
+}
+
+// collectTraversals walks an expression and returns the traversals rooted at rootName (e.g. module, local, var)
+func collectTraversals(expr hcl.Expression, rootName string) []hcl.Traversal {
+	var traversals []hcl.Traversal
+
+	// Expressions outside of native syntax (e.g. JSON) are resolved through their variables
+	if _, ok := expr.(hclsyntax.Expression); !ok {
+		for _, traversal := range expr.Variables() {
+			if traversal.RootName() == rootName {
+				traversals = append(traversals, traversal)
+			}
+		}
+		return traversals
+	}
+
+	switch e := expr.(type) {
+	case *hclsyntax.ScopeTraversalExpr:
+		// Collect traversals such as module.module_name.output_name
+		if e.Traversal.RootName() == rootName {
+			traversals = append(traversals, e.Traversal)
+		}
+
+	case *hclsyntax.TemplateExpr:
+		// Collect traversals in template expressions
+		for _, part := range e.Parts {
+			traversals = append(traversals, collectTraversals(part, rootName)...)
+		}
+
+	case *hclsyntax.TemplateWrapExpr:
+		// Collect traversals in interpolation-only templates (e.g. "${var.a}")
+		if e.Wrapped != nil {
+			traversals = append(traversals, collectTraversals(e.Wrapped, rootName)...)
+		}
+
+	case *hclsyntax.TemplateJoinExpr:
+		// Collect traversals in template for directives (e.g. within heredocs)
+		if e.Tuple != nil {
+			traversals = append(traversals, collectTraversals(e.Tuple, rootName)...)
+		}
+
+	case *hclsyntax.TupleConsExpr:
+		// Collect traversals in tuple expressions
+		for _, expr := range e.Exprs {
+			traversals = append(traversals, collectTraversals(expr, rootName)...)
+		}
+
+	case *hclsyntax.ObjectConsExpr:
+		// Collect traversals in object expressions, including non-literal keys (e.g. (var.a) = ...)
+		for _, item := range e.Items {
+			if item.KeyExpr != nil {
+				traversals = append(traversals, collectTraversals(item.KeyExpr, rootName)...)
+			}
+			if item.ValueExpr != nil {
+				traversals = append(traversals, collectTraversals(item.ValueExpr, rootName)...)
+			}
+		}
+
+	case *hclsyntax.FunctionCallExpr:
+		// Collect traversals in function calls
+		for _, arg := range e.Args {
+			traversals = append(traversals, collectTraversals(arg, rootName)...)
+		}
+
+	case *hclsyntax.ConditionalExpr:
+		// Collect traversals in conditional expressions, including the predicate
+		if e.Condition != nil {
+			traversals = append(traversals, collectTraversals(e.Condition, rootName)...)
+		}
+		if e.TrueResult != nil {
+			traversals = append(traversals, collectTraversals(e.TrueResult, rootName)...)
+		}
+		if e.FalseResult != nil {
+			traversals = append(traversals, collectTraversals(e.FalseResult, rootName)...)
+		}
+
+	case *hclsyntax.IndexExpr:
+		// Collect traversals in index expressions
+		if e.Collection != nil {
+			traversals = append(traversals, collectTraversals(e.Collection, rootName)...)
+		}
+		if e.Key != nil {
+			traversals = append(traversals, collectTraversals(e.Key, rootName)...)
+		}
+
+	case *hclsyntax.SplatExpr:
+		// Collect traversals in splat expressions
+		if e.Source != nil {
+			traversals = append(traversals, collectTraversals(e.Source, rootName)...)
+		}
+		if e.Each != nil {
+			traversals = append(traversals, collectTraversals(e.Each, rootName)...)
+		}
+
+	case *hclsyntax.BinaryOpExpr:
+		// Collect traversals in binary operator expressions
+		if e.LHS != nil {
+			traversals = append(traversals, collectTraversals(e.LHS, rootName)...)
+		}
+		if e.RHS != nil {
+			traversals = append(traversals, collectTraversals(e.RHS, rootName)...)
+		}
+
+	case *hclsyntax.UnaryOpExpr:
+		// Collect traversals in unary operator expressions (e.g. !var.a)
+		if e.Val != nil {
+			traversals = append(traversals, collectTraversals(e.Val, rootName)...)
+		}
+
+	case *hclsyntax.RelativeTraversalExpr:
+		// Collect traversals in the source of relative traversals (e.g. func(...).attr)
+		if e.Source != nil {
+			traversals = append(traversals, collectTraversals(e.Source, rootName)...)
+		}
+
+	case *hclsyntax.ParenthesesExpr:
+		// Collect traversals in parenthesized expressions
+		if e.Expression != nil {
+			traversals = append(traversals, collectTraversals(e.Expression, rootName)...)
+		}
+
+	case *hclsyntax.ForExpr:
+		// Collect traversals in for expressions
+		if e.CollExpr != nil {
+			traversals = append(traversals, collectTraversals(e.CollExpr, rootName)...)
+		}
+		if e.KeyExpr != nil {
+			traversals = append(traversals, collectTraversals(e.KeyExpr, rootName)...)
+		}
+		if e.ValExpr != nil {
+			traversals = append(traversals, collectTraversals(e.ValExpr, rootName)...)
+		}
+		if e.CondExpr != nil {
+			traversals = append(traversals, collectTraversals(e.CondExpr, rootName)...)
+		}
+
+	default:
+		// Fall back to the variables of any other node, so that no reference is dropped
+		// Object keys land here and only report traversals when they are not literal names
+		for _, traversal := range expr.Variables() {
+			if traversal.RootName() == rootName {
+				traversals = append(traversals, traversal)
+			}
+		}
+	}
+
+	return traversals
+}
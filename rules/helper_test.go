@@ -0,0 +1,140 @@
+package rules
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+)
+
+func TestCollectTraversals(t *testing.T) {
+	tests := []struct {
+		name     string
+		expr     string
+		rootName string
+		expected []string
+	}{
+		{
+			name:     "scope traversal",
+			expr:     `module.a.output`,
+			rootName: "module",
+			expected: []string{"module.a"},
+		},
+		{
+			name:     "other root is ignored",
+			expr:     `var.a`,
+			rootName: "module",
+			expected: nil,
+		},
+		{
+			name:     "template expression",
+			expr:     `"${local.a}-${var.b}"`,
+			rootName: "local",
+			expected: []string{"local.a"},
+		},
+		{
+			name:     "template join expression",
+			expr:     "<<EOT\n%{ for v in var.a }${v}%{ endfor }\nEOT\n",
+			rootName: "var",
+			expected: []string{"var.a"},
+		},
+		{
+			name:     "template wrap expression",
+			expr:     `"${var.a}"`,
+			rootName: "var",
+			expected: []string{"var.a"},
+		},
+		{
+			name:     "tuple expression",
+			expr:     `[var.a, var.b]`,
+			rootName: "var",
+			expected: []string{"var.a", "var.b"},
+		},
+		{
+			name:     "object expression",
+			expr:     `{ key = local.a }`,
+			rootName: "local",
+			expected: []string{"local.a"},
+		},
+		{
+			name:     "object expression with non-literal key",
+			expr:     `{ (local.a) = "x", local = "y" }`,
+			rootName: "local",
+			expected: []string{"local.a"},
+		},
+		{
+			name:     "function call",
+			expr:     `concat(var.a, var.b)`,
+			rootName: "var",
+			expected: []string{"var.a", "var.b"},
+		},
+		{
+			name:     "conditional expression",
+			expr:     `module.c.flag ? module.a.output : module.b.output`,
+			rootName: "module",
+			expected: []string{"module.c", "module.a", "module.b"},
+		},
+		{
+			name:     "index expression",
+			expr:     `var.a[var.b]`,
+			rootName: "var",
+			expected: []string{"var.a", "var.b"},
+		},
+		{
+			name:     "splat expression",
+			expr:     `module.a.instances[*].id`,
+			rootName: "module",
+			expected: []string{"module.a"},
+		},
+		{
+			name:     "binary operator expression",
+			expr:     `var.a + var.b`,
+			rootName: "var",
+			expected: []string{"var.a", "var.b"},
+		},
+		{
+			name:     "unary operator expression",
+			expr:     `!var.a`,
+			rootName: "var",
+			expected: []string{"var.a"},
+		},
+		{
+			name:     "relative traversal",
+			expr:     `tomap(local.a).name`,
+			rootName: "local",
+			expected: []string{"local.a"},
+		},
+		{
+			name:     "parenthesized expression",
+			expr:     `(var.a)`,
+			rootName: "var",
+			expected: []string{"var.a"},
+		},
+		{
+			name:     "for expression",
+			expr:     `{ for k, v in var.a : k => v if var.b }`,
+			rootName: "var",
+			expected: []string{"var.a", "var.b"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			expr, diags := hclsyntax.ParseExpression([]byte(test.expr), "test.tf", hcl.InitialPos)
+			if diags.HasErrors() {
+				t.Fatalf("Failed to parse expression: %s", diags)
+			}
+
+			var got []string
+			for _, traversal := range collectTraversals(expr, test.rootName) {
+				name, _ := referenceNameFromTraversal(traversal, test.rootName)
+				got = append(got, traversal.RootName()+"."+name)
+			}
+
+			if strings.Join(got, ",") != strings.Join(test.expected, ",") {
+				t.Errorf("Expected traversals %v, got %v", test.expected, got)
+			}
+		})
+	}
+}
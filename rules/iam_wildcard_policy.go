@@ -0,0 +1,222 @@
+package rules
+
+import (
+	"encoding/json"
+
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// iamPolicyResourceTypes are the resource types holding a policy document in their policy attribute
+var iamPolicyResourceTypes = map[string]bool{
+	"aws_iam_policy":       true,
+	"aws_iam_role_policy":  true,
+	"aws_iam_user_policy":  true,
+	"aws_iam_group_policy": true,
+}
+
+// IamWildcardPolicyRule checks for IAM policies allowing every action on every resource
+type IamWildcardPolicyRule struct {
+	tflint.DefaultRule
+	docsLink
+}
+
+// iamWildcardPolicyRuleConfig is the configuration for the rule
+type iamWildcardPolicyRuleConfig struct {
+	AllowResources []string `hclext:"allow_resources,optional"`
+}
+
+// NewIamWildcardPolicyRule creates a new rule instance
+func NewIamWildcardPolicyRule() *IamWildcardPolicyRule {
+	return &IamWildcardPolicyRule{}
+}
+
+// Name returns the rule name
+func (r *IamWildcardPolicyRule) Name() string {
+	return "iam_wildcard_policy"
+}
+
+// Enabled returns whether the rule is enabled
+func (r *IamWildcardPolicyRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *IamWildcardPolicyRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns a link to detailed information about the rule
+func (r *IamWildcardPolicyRule) Link() string {
+	return r.link(r.Name())
+}
+
+// Check executes the rule checking process
+func (r *IamWildcardPolicyRule) Check(runner tflint.Runner) error {
+	// Decode rule configuration
+	config := iamWildcardPolicyRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	allowed := make(map[string]bool)
+	for _, address := range config.AllowResources {
+		allowed[address] = true
+	}
+
+	files, fileNames, err := sortedFiles(runner)
+	if err != nil {
+		return err
+	}
+
+	for _, fileName := range fileNames {
+		body, ok := files[fileName].Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		for _, block := range body.Blocks {
+			if block.Type != "resource" || len(block.Labels) < 2 || allowed[block.Labels[0]+"."+block.Labels[1]] {
+				continue
+			}
+
+			// Collect policy documents, including inline policies of roles
+			var policies []*hclsyntax.Attribute
+			if iamPolicyResourceTypes[block.Labels[0]] {
+				if attr, exists := block.Body.Attributes["policy"]; exists {
+					policies = append(policies, attr)
+				}
+			}
+			if block.Labels[0] == "aws_iam_role" {
+				for _, inner := range block.Body.Blocks {
+					if inner.Type != "inline_policy" {
+						continue
+					}
+					if attr, exists := inner.Body.Attributes["policy"]; exists {
+						policies = append(policies, attr)
+					}
+				}
+			}
+
+			for _, attr := range policies {
+				if !r.grantsWildcard(attr.Expr) {
+					continue
+				}
+
+				err := runner.EmitIssue(
+					r,
+					`IAM policy grants Action "*" on Resource "*"`,
+					attr.Range(),
+				)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// grantsWildcard returns whether a policy document allows Action "*" on Resource "*"
+// Documents built with jsonencode are inspected statically, while literal JSON strings are decoded
+func (r *IamWildcardPolicyRule) grantsWildcard(expr hclsyntax.Expression) bool {
+	if call, ok := expr.(*hclsyntax.FunctionCallExpr); ok {
+		if call.Name != "jsonencode" || len(call.Args) != 1 {
+			return false
+		}
+		document, ok := call.Args[0].(*hclsyntax.ObjectConsExpr)
+		if !ok {
+			return false
+		}
+
+		statementsExpr, ok := objectItem(document, "Statement")
+		if !ok {
+			return false
+		}
+		var statements []hclsyntax.Expression
+		switch s := statementsExpr.(type) {
+		case *hclsyntax.TupleConsExpr:
+			statements = s.Exprs
+		case *hclsyntax.ObjectConsExpr:
+			statements = []hclsyntax.Expression{s}
+		}
+
+		for _, statement := range statements {
+			obj, ok := statement.(*hclsyntax.ObjectConsExpr)
+			if !ok {
+				continue
+			}
+			if effectExpr, exists := objectItem(obj, "Effect"); exists {
+				if effect, ok := literalString(effectExpr); !ok || effect != "Allow" {
+					continue
+				}
+			}
+			actionExpr, hasAction := objectItem(obj, "Action")
+			resourceExpr, hasResource := objectItem(obj, "Resource")
+			if hasAction && hasResource && r.isWildcardExpr(actionExpr) && r.isWildcardExpr(resourceExpr) {
+				return true
+			}
+		}
+		return false
+	}
+
+	src, ok := literalString(expr)
+	if !ok {
+		return false
+	}
+	var document struct {
+		Statement json.RawMessage
+	}
+	if err := json.Unmarshal([]byte(src), &document); err != nil {
+		return false
+	}
+
+	var statements []map[string]interface{}
+	if err := json.Unmarshal(document.Statement, &statements); err != nil {
+		var statement map[string]interface{}
+		if err := json.Unmarshal(document.Statement, &statement); err != nil {
+			return false
+		}
+		statements = append(statements, statement)
+	}
+
+	for _, statement := range statements {
+		if effect, exists := statement["Effect"]; exists && effect != "Allow" {
+			continue
+		}
+		if r.isWildcardValue(statement["Action"]) && r.isWildcardValue(statement["Resource"]) {
+			return true
+		}
+	}
+	return false
+}
+
+// isWildcardExpr returns whether an expression is "*" or a list containing "*"
+func (r *IamWildcardPolicyRule) isWildcardExpr(expr hclsyntax.Expression) bool {
+	if tuple, ok := expr.(*hclsyntax.TupleConsExpr); ok {
+		for _, elem := range tuple.Exprs {
+			if r.isWildcardExpr(elem) {
+				return true
+			}
+		}
+		return false
+	}
+	value, ok := literalString(expr)
+	return ok && value == "*"
+}
+
+// isWildcardValue returns whether a decoded JSON value is "*" or a list containing "*"
+func (r *IamWildcardPolicyRule) isWildcardValue(value interface{}) bool {
+	switch v := value.(type) {
+	case string:
+		return v == "*"
+	case []interface{}:
+		for _, elem := range v {
+			if r.isWildcardValue(elem) {
+				return true
+			}
+		}
+	}
+	return false
+}
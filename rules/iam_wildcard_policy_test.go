@@ -0,0 +1,170 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestIamWildcardPolicyRule(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		config   string
+		expected helper.Issues
+	}{
+		{
+			name: "wildcard policy",
+			content: `
+resource "aws_iam_policy" "admin" {
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect   = "Allow"
+      Action   = "*"
+      Resource = "*"
+    }]
+  })
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewIamWildcardPolicyRule(),
+					Message: `IAM policy grants Action "*" on Resource "*"`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 10, Column: 5},
+					},
+				},
+			},
+		},
+		{
+			name: "wildcard heredoc policy",
+			content: `
+resource "aws_iam_policy" "admin" {
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": "*",
+      "Resource": "*"
+    }
+  ]
+}
+EOF
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewIamWildcardPolicyRule(),
+					Message: `IAM policy grants Action "*" on Resource "*"`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 14, Column: 4},
+					},
+				},
+			},
+		},
+		{
+			name: "wildcard heredoc policy in inline policy",
+			content: `
+resource "aws_iam_role" "admin" {
+  name = "admin"
+
+  inline_policy {
+    name   = "admin"
+    policy = <<EOT
+{
+  "Version": "2012-10-17",
+  "Statement": {
+    "Effect": "Allow",
+    "Action": ["*"],
+    "Resource": "*"
+  }
+}
+EOT
+  }
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewIamWildcardPolicyRule(),
+					Message: `IAM policy grants Action "*" on Resource "*"`,
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 7, Column: 5},
+						End:      hcl.Pos{Line: 16, Column: 4},
+					},
+				},
+			},
+		},
+		{
+			name: "scoped policy",
+			content: `
+resource "aws_iam_policy" "read" {
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect   = "Allow"
+      Action   = ["s3:GetObject"]
+      Resource = aws_s3_bucket.main.arn
+    }]
+  })
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "non-IAM resource",
+			content: `
+resource "aws_s3_bucket_policy" "main" {
+  bucket = "example"
+  policy = jsonencode({
+    Statement = [{
+      Effect   = "Allow"
+      Action   = "*"
+      Resource = "*"
+    }]
+  })
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "allowed resource",
+			content: `
+resource "aws_iam_policy" "admin" {
+  policy = jsonencode({
+    Statement = [{
+      Effect   = "Allow"
+      Action   = "*"
+      Resource = "*"
+    }]
+  })
+}`,
+			config: `
+rule "iam_wildcard_policy" {
+  enabled         = true
+  allow_resources = ["aws_iam_policy.admin"]
+}`,
+			expected: helper.Issues{},
+		},
+	}
+
+	rule := NewIamWildcardPolicyRule()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			files := map[string]string{"main.tf": test.content}
+			if test.config != "" {
+				files[".tflint.hcl"] = test.config
+			}
+			runner := helper.TestRunner(t, files)
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.expected, runner.Issues)
+		})
+	}
+}
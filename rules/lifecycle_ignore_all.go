@@ -0,0 +1,99 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// LifecycleIgnoreAllRule checks that resources do not ignore changes to all attributes
+type LifecycleIgnoreAllRule struct {
+	tflint.DefaultRule
+	docsLink
+}
+
+// lifecycleIgnoreAllRuleConfig is the configuration for the rule
+type lifecycleIgnoreAllRuleConfig struct {
+	AllowTypes []string `hclext:"allow_types,optional"`
+}
+
+// NewLifecycleIgnoreAllRule creates a new rule instance
+func NewLifecycleIgnoreAllRule() *LifecycleIgnoreAllRule {
+	return &LifecycleIgnoreAllRule{}
+}
+
+// Name returns the rule name
+func (r *LifecycleIgnoreAllRule) Name() string {
+	return "lifecycle_ignore_all"
+}
+
+// Enabled returns whether the rule is enabled
+func (r *LifecycleIgnoreAllRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *LifecycleIgnoreAllRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns a link to detailed information about the rule
+func (r *LifecycleIgnoreAllRule) Link() string {
+	return r.link(r.Name())
+}
+
+// Check executes the rule checking process
+func (r *LifecycleIgnoreAllRule) Check(runner tflint.Runner) error {
+	// Decode rule configuration
+	config := lifecycleIgnoreAllRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	allowedTypes := make(map[string]bool)
+	for _, t := range config.AllowTypes {
+		allowedTypes[t] = true
+	}
+
+	files, fileNames, err := sortedFiles(runner)
+	if err != nil {
+		return err
+	}
+
+	for _, fileName := range fileNames {
+		body, ok := files[fileName].Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		for _, block := range body.Blocks {
+			if block.Type != "resource" || len(block.Labels) < 2 || allowedTypes[block.Labels[0]] {
+				continue
+			}
+
+			for _, inner := range block.Body.Blocks {
+				if inner.Type != "lifecycle" {
+					continue
+				}
+
+				attr, exists := inner.Body.Attributes["ignore_changes"]
+				if !exists || hcl.ExprAsKeyword(attr.Expr) != "all" {
+					continue
+				}
+
+				err := runner.EmitIssue(
+					r,
+					fmt.Sprintf("Resource \"%s.%s\" uses ignore_changes = all, which masks drift", block.Labels[0], block.Labels[1]),
+					attr.Range(),
+				)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestLifecycleIgnoreAllRule(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		config   string
+		expected helper.Issues
+	}{
+		{
+			name: "ignore all changes",
+			content: `
+resource "aws_instance" "web" {
+  ami = "ami-123456"
+
+  lifecycle {
+    ignore_changes = all
+  }
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewLifecycleIgnoreAllRule(),
+					Message: "Resource \"aws_instance.web\" uses ignore_changes = all, which masks drift",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 6, Column: 5},
+						End:      hcl.Pos{Line: 6, Column: 25},
+					},
+				},
+			},
+		},
+		{
+			name: "ignore specific attributes",
+			content: `
+resource "aws_instance" "web" {
+  ami = "ami-123456"
+
+  lifecycle {
+    ignore_changes = [tags]
+  }
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "no lifecycle block",
+			content: `
+resource "aws_instance" "web" {
+  ami = "ami-123456"
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "allowed type",
+			content: `
+resource "aws_instance" "web" {
+  ami = "ami-123456"
+
+  lifecycle {
+    ignore_changes = all
+  }
+}`,
+			config: `
+rule "lifecycle_ignore_all" {
+  enabled     = true
+  allow_types = ["aws_instance"]
+}`,
+			expected: helper.Issues{},
+		},
+	}
+
+	rule := NewLifecycleIgnoreAllRule()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			files := map[string]string{"main.tf": test.content}
+			if test.config != "" {
+				files[".tflint.hcl"] = test.config
+			}
+			runner := helper.TestRunner(t, files)
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package rules
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// LocalNamingConventionRule checks that local value names follow a naming convention
+type LocalNamingConventionRule struct {
+	tflint.DefaultRule
+	docsLink
+}
+
+// localNamingConventionRuleConfig is the configuration for the rule
+type localNamingConventionRuleConfig struct {
+	Format string `hclext:"format,optional"`
+	Regex  string `hclext:"regex,optional"`
+}
+
+// NewLocalNamingConventionRule creates a new rule instance
+func NewLocalNamingConventionRule() *LocalNamingConventionRule {
+	return &LocalNamingConventionRule{}
+}
+
+// Name returns the rule name
+func (r *LocalNamingConventionRule) Name() string {
+	return "local_naming_convention"
+}
+
+// Enabled returns whether the rule is enabled
+func (r *LocalNamingConventionRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *LocalNamingConventionRule) Severity() tflint.Severity {
+	return tflint.NOTICE
+}
+
+// Link returns a link to detailed information about the rule
+func (r *LocalNamingConventionRule) Link() string {
+	return r.link(r.Name())
+}
+
+// Check executes the rule checking process
+func (r *LocalNamingConventionRule) Check(runner tflint.Runner) error {
+	// Decode rule configuration
+	config := localNamingConventionRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	pattern, label, err := namingPattern(config.Format, config.Regex)
+	if err != nil {
+		return err
+	}
+
+	files, fileNames, err := sortedFiles(runner)
+	if err != nil {
+		return err
+	}
+
+	for _, fileName := range fileNames {
+		body, ok := files[fileName].Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		for _, block := range body.Blocks {
+			if block.Type != "locals" {
+				continue
+			}
+
+			// The keys of a locals block are its attributes
+			var attrs []*hclsyntax.Attribute
+			for _, attr := range block.Body.Attributes {
+				attrs = append(attrs, attr)
+			}
+
+			// Sort attributes by position (by line number)
+			sort.Slice(attrs, func(i, j int) bool {
+				return attrs[i].Range().Start.Line < attrs[j].Range().Start.Line
+			})
+
+			for _, attr := range attrs {
+				if pattern.MatchString(attr.Name) {
+					continue
+				}
+
+				err := runner.EmitIssue(
+					r,
+					fmt.Sprintf("Local value \"%s\" does not match %s", attr.Name, label),
+					attr.NameRange,
+				)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestLocalNamingConventionRule(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		config   string
+		expected helper.Issues
+	}{
+		{
+			name: "snake_case keys",
+			content: `
+locals {
+  my_value    = "a"
+  other_value = "b"
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "camelCase key",
+			content: `
+locals {
+  my_value = "a"
+  myValue  = "b"
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewLocalNamingConventionRule(),
+					Message: "Local value \"myValue\" does not match snake_case",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 4, Column: 3},
+						End:      hcl.Pos{Line: 4, Column: 10},
+					},
+				},
+			},
+		},
+		{
+			name: "camelCase key with custom regex",
+			content: `
+locals {
+  myValue = "a"
+}`,
+			config: `
+rule "local_naming_convention" {
+  enabled = true
+  regex = "^[a-z][a-zA-Z0-9]*$"
+}`,
+			expected: helper.Issues{},
+		},
+	}
+
+	rule := NewLocalNamingConventionRule()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			files := map[string]string{"main.tf": test.content}
+			if test.config != "" {
+				files[".tflint.hcl"] = test.config
+			}
+			runner := helper.TestRunner(t, files)
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// defaultMaxModulesPerFile is the limit used when max is not configured
+const defaultMaxModulesPerFile = 10
+
+// MaxModulesPerFileRule checks that a file does not declare too many module blocks
+type MaxModulesPerFileRule struct {
+	tflint.DefaultRule
+	docsLink
+}
+
+// maxModulesPerFileRuleConfig is the configuration for the rule
+type maxModulesPerFileRuleConfig struct {
+	Max int `hclext:"max,optional"`
+}
+
+// NewMaxModulesPerFileRule creates a new rule instance
+func NewMaxModulesPerFileRule() *MaxModulesPerFileRule {
+	return &MaxModulesPerFileRule{}
+}
+
+// Name returns the rule name
+func (r *MaxModulesPerFileRule) Name() string {
+	return "max_modules_per_file"
+}
+
+// Enabled returns whether the rule is enabled
+func (r *MaxModulesPerFileRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *MaxModulesPerFileRule) Severity() tflint.Severity {
+	return tflint.NOTICE
+}
+
+// Link returns a link to detailed information about the rule
+func (r *MaxModulesPerFileRule) Link() string {
+	return r.link(r.Name())
+}
+
+// Check executes the rule checking process
+func (r *MaxModulesPerFileRule) Check(runner tflint.Runner) error {
+	// Decode rule configuration
+	config := maxModulesPerFileRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+	limit := config.Max
+	if limit <= 0 {
+		limit = defaultMaxModulesPerFile
+	}
+
+	files, fileNames, err := sortedFiles(runner)
+	if err != nil {
+		return err
+	}
+
+	for _, fileName := range fileNames {
+		body, ok := files[fileName].Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		count := 0
+		var exceededAt hcl.Range // Range of the first block over the limit
+		for _, block := range body.Blocks {
+			if block.Type != "module" {
+				continue
+			}
+			count++
+			if count == limit+1 {
+				exceededAt = block.DefRange()
+			}
+		}
+		if count <= limit {
+			continue
+		}
+
+		err := runner.EmitIssue(
+			r,
+			fmt.Sprintf("File %s declares %d module blocks, exceeding %d", fileName, count, limit),
+			exceededAt,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestMaxModulesPerFileRule(t *testing.T) {
+	content := `
+module "network" {
+  source = "./modules/network"
+}
+
+module "database" {
+  source = "./modules/database"
+}
+
+module "app" {
+  source = "./modules/app"
+}`
+
+	tests := []struct {
+		name     string
+		config   string
+		expected helper.Issues
+	}{
+		{
+			name:     "below the default limit",
+			expected: helper.Issues{},
+		},
+		{
+			name: "at the limit",
+			config: `
+rule "max_modules_per_file" {
+  enabled = true
+  max = 3
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "above the limit",
+			config: `
+rule "max_modules_per_file" {
+  enabled = true
+  max = 2
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewMaxModulesPerFileRule(),
+					Message: "File main.tf declares 3 module blocks, exceeding 2",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 10, Column: 1},
+						End:      hcl.Pos{Line: 10, Column: 13},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewMaxModulesPerFileRule()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			files := map[string]string{"main.tf": content}
+			if test.config != "" {
+				files[".tflint.hcl"] = test.config
+			}
+			runner := helper.TestRunner(t, files)
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.expected, runner.Issues)
+		})
+	}
+}
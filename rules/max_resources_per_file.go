@@ -0,0 +1,99 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// defaultMaxResourcesPerFile is the limit used when max is not configured
+const defaultMaxResourcesPerFile = 20
+
+// MaxResourcesPerFileRule checks that a file does not declare too many resources
+type MaxResourcesPerFileRule struct {
+	tflint.DefaultRule
+	docsLink
+}
+
+// maxResourcesPerFileRuleConfig is the configuration for the rule
+type maxResourcesPerFileRuleConfig struct {
+	Max int `hclext:"max,optional"`
+}
+
+// NewMaxResourcesPerFileRule creates a new rule instance
+func NewMaxResourcesPerFileRule() *MaxResourcesPerFileRule {
+	return &MaxResourcesPerFileRule{}
+}
+
+// Name returns the rule name
+func (r *MaxResourcesPerFileRule) Name() string {
+	return "max_resources_per_file"
+}
+
+// Enabled returns whether the rule is enabled
+func (r *MaxResourcesPerFileRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *MaxResourcesPerFileRule) Severity() tflint.Severity {
+	return tflint.NOTICE
+}
+
+// Link returns a link to detailed information about the rule
+func (r *MaxResourcesPerFileRule) Link() string {
+	return r.link(r.Name())
+}
+
+// Check executes the rule checking process
+func (r *MaxResourcesPerFileRule) Check(runner tflint.Runner) error {
+	// Decode rule configuration
+	config := maxResourcesPerFileRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+	limit := config.Max
+	if limit <= 0 {
+		limit = defaultMaxResourcesPerFile
+	}
+
+	files, fileNames, err := sortedFiles(runner)
+	if err != nil {
+		return err
+	}
+
+	for _, fileName := range fileNames {
+		body, ok := files[fileName].Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		count := 0
+		var exceededAt hcl.Range // Range of the first block over the limit
+		for _, block := range body.Blocks {
+			if block.Type != "resource" && block.Type != "data" {
+				continue
+			}
+			count++
+			if count == limit+1 {
+				exceededAt = block.DefRange()
+			}
+		}
+		if count <= limit {
+			continue
+		}
+
+		err := runner.EmitIssue(
+			r,
+			fmt.Sprintf("File %s has %d resource blocks, exceeding %d", fileName, count, limit),
+			exceededAt,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
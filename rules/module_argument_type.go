@@ -0,0 +1,194 @@
+package rules
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// typeKinds groups type names that Terraform can convert between
+var typeKinds = map[string]string{
+	"string": "primitive",
+	"number": "primitive",
+	"bool":   "primitive",
+	"list":   "sequence",
+	"set":    "sequence",
+	"tuple":  "sequence",
+	"map":    "mapping",
+	"object": "mapping",
+}
+
+// ModuleArgumentTypeRule checks that literal module arguments match the type of the local module variable
+type ModuleArgumentTypeRule struct {
+	tflint.DefaultRule
+	docsLink
+}
+
+// NewModuleArgumentTypeRule creates a new rule instance
+func NewModuleArgumentTypeRule() *ModuleArgumentTypeRule {
+	return &ModuleArgumentTypeRule{}
+}
+
+// Name returns the rule name
+func (r *ModuleArgumentTypeRule) Name() string {
+	return "module_argument_type"
+}
+
+// Enabled returns whether the rule is enabled
+func (r *ModuleArgumentTypeRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *ModuleArgumentTypeRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns a link to detailed information about the rule
+func (r *ModuleArgumentTypeRule) Link() string {
+	return r.link(r.Name())
+}
+
+// Check executes the rule checking process
+func (r *ModuleArgumentTypeRule) Check(runner tflint.Runner) error {
+	files, fileNames, err := sortedFiles(runner)
+	if err != nil {
+		return err
+	}
+
+	// Variable types are shared between modules with the same source
+	parsed := make(map[string]map[string]string)
+
+	for _, fileName := range fileNames {
+		body, ok := files[fileName].Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		for _, block := range body.Blocks {
+			if block.Type != "module" || len(block.Labels) == 0 {
+				continue
+			}
+			sourceAttr, exists := block.Body.Attributes["source"]
+			if !exists {
+				continue
+			}
+			dir, ok := localModuleDir(sourceAttr.Expr)
+			if !ok {
+				continue
+			}
+
+			variables, exists := parsed[dir]
+			if !exists {
+				variables, err = moduleVariableTypes(dir)
+				if err != nil {
+					return err
+				}
+				parsed[dir] = variables
+			}
+
+			names := make([]string, 0, len(block.Body.Attributes))
+			for name := range block.Body.Attributes {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+
+			for _, name := range names {
+				attr := block.Body.Attributes[name]
+				expected, exists := variables[name]
+				if !exists {
+					continue
+				}
+				actual, ok := literalTypeName(attr.Expr)
+				if !ok || typeKinds[actual] == typeKinds[expected] {
+					continue
+				}
+
+				err := runner.EmitIssue(
+					r,
+					fmt.Sprintf("Module \"%s\" argument \"%s\" is a %s but variable expects %s", block.Labels[0], attr.Name, actual, expected),
+					attr.Expr.Range(),
+				)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// moduleVariableTypes returns the declared type name of each variable in a local module directory
+// Variables without a type or declared as any are left out
+func moduleVariableTypes(dir string) (map[string]string, error) {
+	files, err := parseModuleDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	types := make(map[string]string)
+	for _, file := range files {
+		content, _, diags := file.Body.PartialContent(&hcl.BodySchema{
+			Blocks: []hcl.BlockHeaderSchema{
+				{Type: "variable", LabelNames: []string{"name"}},
+			},
+		})
+		if diags.HasErrors() {
+			return nil, diags
+		}
+
+		for _, block := range content.Blocks {
+			attrs, _, diags := block.Body.PartialContent(&hcl.BodySchema{
+				Attributes: []hcl.AttributeSchema{{Name: "type"}},
+			})
+			if diags.HasErrors() {
+				return nil, diags
+			}
+			typeAttr, exists := attrs.Attributes["type"]
+			if !exists {
+				continue
+			}
+
+			// Primitive types are bare keywords, collection and structural types are constructor calls
+			name := hcl.ExprAsKeyword(typeAttr.Expr)
+			if call, diags := hcl.ExprCall(typeAttr.Expr); !diags.HasErrors() {
+				name = call.Name
+			}
+			if _, known := typeKinds[name]; known {
+				types[block.Labels[0]] = name
+			}
+		}
+	}
+
+	return types, nil
+}
+
+// literalTypeName returns the type name of a literal expression
+// It returns false for null and for expressions whose type cannot be known statically
+func literalTypeName(expr hclsyntax.Expression) (string, bool) {
+	switch e := expr.(type) {
+	case *hclsyntax.TupleConsExpr:
+		return "list", true
+	case *hclsyntax.ObjectConsExpr:
+		return "map", true
+	case *hclsyntax.TemplateExpr:
+		if _, ok := literalString(e); ok {
+			return "string", true
+		}
+	case *hclsyntax.LiteralValueExpr:
+		if e.Val.IsNull() {
+			return "", false
+		}
+		for _, t := range []cty.Type{cty.String, cty.Number, cty.Bool} {
+			if e.Val.Type().Equals(t) {
+				return t.FriendlyName(), true
+			}
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,126 @@
+package rules
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestModuleArgumentTypeRule(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "modules", "service"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "modules", "service", "variables.tf"), []byte(`
+variable "name" {
+  type = string
+}
+
+variable "ports" {
+  type = string
+}
+
+variable "tags" {
+  type = map(string)
+}
+
+variable "settings" {}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "modules", "service", "variables.tf.json"), []byte(`{
+  "variable": {
+    "subnets": {
+      "type": "list(string)"
+    }
+  }
+}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	mainFile := filepath.Join(dir, "main.tf")
+
+	tests := []struct {
+		name     string
+		content  string
+		expected helper.Issues
+	}{
+		{
+			name: "matching types",
+			content: `
+module "service" {
+  source   = "./modules/service"
+  name     = "web"
+  ports    = var.ports
+  tags     = { Team = "platform" }
+  settings = ["a", "b"]
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "mismatched types",
+			content: `
+module "service" {
+  source = "./modules/service"
+  name   = "web"
+  ports  = [80, 443]
+  tags   = "platform"
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewModuleArgumentTypeRule(),
+					Message: "Module \"service\" argument \"ports\" is a list but variable expects string",
+					Range: hcl.Range{
+						Filename: mainFile,
+						Start:    hcl.Pos{Line: 5, Column: 12},
+						End:      hcl.Pos{Line: 5, Column: 21},
+					},
+				},
+				{
+					Rule:    NewModuleArgumentTypeRule(),
+					Message: "Module \"service\" argument \"tags\" is a string but variable expects map",
+					Range: hcl.Range{
+						Filename: mainFile,
+						Start:    hcl.Pos{Line: 6, Column: 12},
+						End:      hcl.Pos{Line: 6, Column: 22},
+					},
+				},
+			},
+		},
+		{
+			name: "variable declared in a JSON file",
+			content: `
+module "service" {
+  source  = "./modules/service"
+  subnets = "subnet-123"
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewModuleArgumentTypeRule(),
+					Message: "Module \"service\" argument \"subnets\" is a string but variable expects list",
+					Range: hcl.Range{
+						Filename: mainFile,
+						Start:    hcl.Pos{Line: 4, Column: 13},
+						End:      hcl.Pos{Line: 4, Column: 25},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewModuleArgumentTypeRule()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{mainFile: test.content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.expected, runner.Issues)
+		})
+	}
+}
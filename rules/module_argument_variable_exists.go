@@ -0,0 +1,98 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// ModuleArgumentVariableExistsRule checks that module arguments only reference declared variables
+type ModuleArgumentVariableExistsRule struct {
+	tflint.DefaultRule
+	docsLink
+}
+
+// NewModuleArgumentVariableExistsRule creates a new rule instance
+func NewModuleArgumentVariableExistsRule() *ModuleArgumentVariableExistsRule {
+	return &ModuleArgumentVariableExistsRule{}
+}
+
+// Name returns the rule name
+func (r *ModuleArgumentVariableExistsRule) Name() string {
+	return "module_argument_variable_exists"
+}
+
+// Enabled returns whether the rule is enabled
+func (r *ModuleArgumentVariableExistsRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *ModuleArgumentVariableExistsRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns a link to detailed information about the rule
+func (r *ModuleArgumentVariableExistsRule) Link() string {
+	return r.link(r.Name())
+}
+
+// Check executes the rule checking process
+func (r *ModuleArgumentVariableExistsRule) Check(runner tflint.Runner) error {
+	files, fileNames, err := sortedFiles(runner)
+	if err != nil {
+		return err
+	}
+
+	// Collect declared variables
+	declared := make(map[string]bool)
+	for _, fileName := range fileNames {
+		body, ok := files[fileName].Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+		for _, block := range body.Blocks {
+			if block.Type == "variable" && len(block.Labels) > 0 {
+				declared[block.Labels[0]] = true
+			}
+		}
+	}
+
+	for _, fileName := range fileNames {
+		body, ok := files[fileName].Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		for _, block := range body.Blocks {
+			if block.Type != "module" || len(block.Labels) == 0 {
+				continue
+			}
+
+			var emitErr error
+			walkAttributes(block.Body, func(attr *hclsyntax.Attribute) {
+				for _, traversal := range collectTraversals(attr.Expr, "var") {
+					if emitErr != nil {
+						return
+					}
+					name, ok := referenceNameFromTraversal(traversal, "var")
+					if !ok || declared[name] {
+						continue
+					}
+
+					emitErr = runner.EmitIssue(
+						r,
+						fmt.Sprintf("Module \"%s\" argument references undeclared variable \"%s\"", block.Labels[0], name),
+						traversal.SourceRange(),
+					)
+				}
+			})
+			if emitErr != nil {
+				return emitErr
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestModuleArgumentVariableExistsRule(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected helper.Issues
+	}{
+		{
+			name: "declared variable",
+			content: `
+variable "environment" {
+  type = string
+}
+
+module "app" {
+  source = "./modules/app"
+  name   = "app-${var.environment}"
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "undeclared variable",
+			content: `
+variable "environment" {
+  type = string
+}
+
+module "app" {
+  source = "./modules/app"
+  name   = "app-${var.enviroment}"
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewModuleArgumentVariableExistsRule(),
+					Message: "Module \"app\" argument references undeclared variable \"enviroment\"",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 8, Column: 19},
+						End:      hcl.Pos{Line: 8, Column: 33},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewModuleArgumentVariableExistsRule()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": test.content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.expected, runner.Issues)
+		})
+	}
+}
@@ -1,17 +1,40 @@
 package rules
 
 import (
+	"encoding/json"
 	"fmt"
+	"os"
 	"sort"
+	"strings"
 
 	"github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/hcl/v2/hclsyntax"
 	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
 )
 
 // ModuleCircularDependencyRule prevents circular dependencies between modules
 type ModuleCircularDependencyRule struct {
 	tflint.DefaultRule
+	docsLink
+
+	enabled  bool
+	severity tflint.Severity
+}
+
+// moduleCircularDependencyRuleConfig is the configuration for the rule
+type moduleCircularDependencyRuleConfig struct {
+	MaxCycleLength     int      `hclext:"max_cycle_length,optional"`
+	IgnoreCycles       []string `hclext:"ignore_cycles,optional"`
+	ReportFile         string   `hclext:"report_file,optional"`
+	CollapseCycles     bool     `hclext:"collapse_cycles,optional"`
+	LongCycleThreshold int      `hclext:"long_cycle_threshold,optional"`
+	Severity           string   `hclext:"severity,optional"`
+	ExcludePaths       []string `hclext:"exclude_paths,optional"`
+	ReportDepth        bool     `hclext:"report_depth,optional"`
+	NamePrefix         string   `hclext:"name_prefix,optional"`
+	FollowNested       bool     `hclext:"follow_nested,optional"`
+	IgnoreDisabled     bool     `hclext:"ignore_disabled,optional"`
 }
 
 // NewModuleCircularDependencyRule creates a new rule instance
@@ -25,46 +48,164 @@ func (r *ModuleCircularDependencyRule) Name() string {
 }
 
 // Enabled returns whether the rule is enabled
+// It is disabled by default and reflects the state applied by tflint afterwards
 func (r *ModuleCircularDependencyRule) Enabled() bool {
-	return false
+	return r.enabled
+}
+
+// SetEnabled updates the enabled state of the rule
+func (r *ModuleCircularDependencyRule) SetEnabled(enabled bool) {
+	r.enabled = enabled
 }
 
 // Severity returns the rule severity
+// It defaults to ERROR and can be lowered through the severity option
 func (r *ModuleCircularDependencyRule) Severity() tflint.Severity {
+	if r.severity != 0 {
+		return r.severity
+	}
 	return tflint.ERROR
 }
 
 // Link returns a link to detailed information about the rule
 func (r *ModuleCircularDependencyRule) Link() string {
-	return "https://github.com/takaishi/tflint-ruleset-takaishi"
+	return r.link(r.Name())
 }
 
 // Check executes the rule checking process
 func (r *ModuleCircularDependencyRule) Check(runner tflint.Runner) error {
-	// Collect module definitions
-	modules, err := r.collectModules(runner)
+	// Decode rule configuration
+	config := moduleCircularDependencyRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	// Store the configured severity since the SDK calls Severity() independently of Check
+	switch config.Severity {
+	case "":
+		r.severity = 0
+	case "error":
+		r.severity = tflint.ERROR
+	case "warning":
+		r.severity = tflint.WARNING
+	case "notice":
+		r.severity = tflint.NOTICE
+	default:
+		return fmt.Errorf("invalid severity %q: must be error, warning or notice", config.Severity)
+	}
+
+	// Get files once and share them between the collect and build phases
+	files, fileNames, err := sortedFiles(runner)
 	if err != nil {
 		return err
 	}
 
-	// Build dependency relationships between modules
-	dependencies, err := r.buildDependencies(runner, modules)
+	// Skip generated or vendored files in both phases
+	fileNames, err = excludeFiles(fileNames, config.ExcludePaths)
+	if err != nil {
+		return err
+	}
+
+	// Extract module blocks and local values in a single pass over the files
+	graph, err := parseModuleGraph(files, fileNames)
 	if err != nil {
 		return err
 	}
 
+	// Collect module definitions
+	modules := graph.modules()
+
+	// Only consider modules in the configured namespace
+	if config.NamePrefix != "" {
+		for name := range modules {
+			if !strings.HasPrefix(name, config.NamePrefix) {
+				delete(modules, name)
+			}
+		}
+	}
+
+	// Modules that are never instantiated cannot take part in a cycle
+	if config.IgnoreDisabled {
+		for _, block := range graph.blocks {
+			if block.disabled() {
+				delete(modules, block.Name)
+			}
+		}
+	}
+
+	// Build dependency relationships between modules
+	dependencies := graph.dependencies(modules)
+
+	// Follow local modules into their own module calls if requested
+	// Nested dependencies only take part in cycle detection, not in the depth report
+	cycleDependencies := dependencies
+	if config.FollowNested {
+		nested, err := r.nestedDependencies(graph, modules)
+		if err != nil {
+			return err
+		}
+		cycleDependencies = append(append([]Dependency{}, dependencies...), nested...)
+	}
+
 	// Detect circular dependencies
-	circularDeps := r.detectCircularDependencies(dependencies)
+	circularDeps := r.detectCircularDependencies(cycleDependencies, config)
+
+	// Write a machine-readable report if requested
+	if config.ReportFile != "" {
+		if err := r.writeReport(config.ReportFile, circularDeps); err != nil {
+			return err
+		}
+	}
 
 	// Report errors
+	collapsedCycles := make(map[string]bool) // Track cycles emitted when collapse_cycles is enabled
 	for _, dep := range circularDeps {
+		// Suggest a refactor for cycles spanning many modules
+		hint := ""
+		if config.LongCycleThreshold > 0 && len(dep.Cycle) > config.LongCycleThreshold {
+			hint = fmt.Sprintf(" (cycle spans %d modules; consider introducing a shared data module)", len(dep.Cycle))
+		}
+
+		// Emit only one issue per cycle when collapse_cycles is enabled
+		if config.CollapseCycles && dep.ModuleA != dep.ModuleB {
+			cycleKey := r.normalizeCycle(dep.Cycle)
+			if collapsedCycles[cycleKey] {
+				continue
+			}
+			collapsedCycles[cycleKey] = true
+
+			cyclePath := dep.CyclePath
+			if cyclePath == "" {
+				cyclePath = r.formatCyclePath(dep.Cycle)
+			}
+
+			anchor := "direct-cycles"
+			if len(dep.Cycle) > 2 {
+				anchor = "indirect-cycles"
+			}
+
+			err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("Circular dependency: %s%s%s", cyclePath, hint, r.docsReference(anchor)),
+				dep.Range,
+			)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
 		var message string
-		if dep.CyclePath != "" {
+		switch {
+		case dep.ModuleA == dep.ModuleB:
+			// For modules referencing their own outputs
+			message = fmt.Sprintf("Self-referencing module detected: %s", dep.ModuleA)
+		case dep.CyclePath != "":
 			// For indirect circular dependencies, show the entire cycle path
-			message = fmt.Sprintf("Circular dependency detected between modules: %s ↔ %s (path: %s)", dep.ModuleA, dep.ModuleB, dep.CyclePath)
-		} else {
+			message = fmt.Sprintf("Circular dependency detected between modules: %s ↔ %s (path: %s)%s%s", dep.ModuleA, dep.ModuleB, dep.CyclePath, hint, r.docsReference("indirect-cycles"))
+		default:
 			// For direct circular dependencies
-			message = fmt.Sprintf("Circular dependency detected between modules: %s ↔ %s", dep.ModuleA, dep.ModuleB)
+			message = fmt.Sprintf("Circular dependency detected between modules: %s ↔ %s%s", dep.ModuleA, dep.ModuleB, r.docsReference("direct-cycles"))
 		}
 
 		err := runner.EmitIssue(
@@ -75,14 +216,67 @@ func (r *ModuleCircularDependencyRule) Check(runner tflint.Runner) error {
 		if err != nil {
 			return err
 		}
+
+		// For direct circular dependencies, also point at the reverse edge
+		// The cycle is already reported above, so this location is informational only
+		if dep.SecondaryRange.Filename != "" {
+			err := runner.EmitIssue(
+				noticeRule{r},
+				fmt.Sprintf("Circular dependency detected between modules: %s ↔ %s%s", dep.ModuleB, dep.ModuleA, r.docsReference("direct-cycles")),
+				dep.SecondaryRange,
+			)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	// Report the longest dependency chain from each root module if requested
+	if config.ReportDepth {
+		depths := r.dependencyDepths(r.dependencyMap(dependencies))
+
+		var roots []string
+		for root := range depths {
+			roots = append(roots, root)
+		}
+		sort.Strings(roots)
+
+		// Depths are informational, so they are reported at NOTICE and never fail the run
+		for _, root := range roots {
+			err := runner.EmitIssue(
+				noticeRule{r},
+				fmt.Sprintf("Module \"%s\" has a dependency depth of %d", root, depths[root]),
+				modules[root].DefRange,
+			)
+			if err != nil {
+				return err
+			}
+		}
 	}
 
 	return nil
 }
 
+// docsReference returns the message suffix pointing at the documentation section for a cycle type
+// Link() is rule-wide, so the section anchor is carried in the message instead
+func (r *ModuleCircularDependencyRule) docsReference(anchor string) string {
+	return fmt.Sprintf(" (see %s#%s)", r.Link(), anchor)
+}
+
+// noticeRule reports informational issues of a rule at NOTICE regardless of the rule severity
+type noticeRule struct {
+	tflint.Rule
+}
+
+// Severity returns the notice severity
+func (r noticeRule) Severity() tflint.Severity {
+	return tflint.NOTICE
+}
+
 // ModuleInfo holds module information
 type ModuleInfo struct {
-	Name string
+	Name     string
+	DefRange hcl.Range
 }
 
 // Dependency represents a dependency relationship between modules
@@ -93,215 +287,423 @@ type Dependency struct {
 }
 
 // CircularDependency represents a circular dependency
+// ModuleA and ModuleB are equal when a module references itself
 type CircularDependency struct {
-	ModuleA   string
-	ModuleB   string
-	Range     hcl.Range
-	CyclePath string // Path of the entire cycle (for indirect circular dependencies)
+	ModuleA        string
+	ModuleB        string
+	Range          hcl.Range
+	SecondaryRange hcl.Range // Range of the reverse edge (for direct circular dependencies)
+	CyclePath      string    // Path of the entire cycle (for indirect circular dependencies)
+	Cycle          []string  // Modules in the cycle in dependency order
 }
 
-// collectModules collects all module definitions
-func (r *ModuleCircularDependencyRule) collectModules(runner tflint.Runner) (map[string]ModuleInfo, error) {
-	modules := make(map[string]ModuleInfo)
+// cycleReport is the structure of the JSON report written to report_file
+type cycleReport struct {
+	Cycles []cycleReportEntry `json:"cycles"`
+}
+
+// cycleReportEntry represents a single detected circular dependency in the report
+type cycleReportEntry struct {
+	ModuleA   string `json:"module_a"`
+	ModuleB   string `json:"module_b"`
+	CyclePath string `json:"cycle_path"`
+	Filename  string `json:"filename"`
+	Line      int    `json:"line"`
+}
+
+// writeReport serializes the detected circular dependencies to a JSON file
+func (r *ModuleCircularDependencyRule) writeReport(path string, circularDeps []CircularDependency) error {
+	report := cycleReport{Cycles: []cycleReportEntry{}}
+	for _, dep := range circularDeps {
+		cyclePath := dep.CyclePath
+		if cyclePath == "" {
+			cyclePath = r.formatCyclePath(dep.Cycle)
+		}
+
+		report.Cycles = append(report.Cycles, cycleReportEntry{
+			ModuleA:   dep.ModuleA,
+			ModuleB:   dep.ModuleB,
+			CyclePath: cyclePath,
+			Filename:  dep.Range.Filename,
+			Line:      dep.Range.Start.Line,
+		})
+	}
 
-	files, err := runner.GetFiles()
+	data, err := json.MarshalIndent(report, "", "  ")
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	// Sort by filename for deterministic order
-	var fileNames []string
-	for fileName := range files {
-		fileNames = append(fileNames, fileName)
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write report file %s: %w", path, err)
 	}
-	sort.Strings(fileNames)
+	return nil
+}
+
+// moduleBlock is a module block with its attributes in source order
+type moduleBlock struct {
+	Name     string
+	DefRange hcl.Range
+	Attrs    []*hcl.Attribute
+}
+
+// moduleGraph holds the module blocks and local values extracted from the files
+// It is built in a single pass so that dependencies can be resolved without walking the files again
+type moduleGraph struct {
+	blocks []moduleBlock
+	locals map[string]hcl.Expression
+}
+
+// parseModuleGraph extracts module blocks and local values from the files in a single pass
+func parseModuleGraph(files map[string]*hcl.File, fileNames []string) (*moduleGraph, error) {
+	graph := &moduleGraph{locals: make(map[string]hcl.Expression)}
 
 	for _, fileName := range fileNames {
 		file := files[fileName]
 		body, ok := file.Body.(*hclsyntax.Body)
 		if !ok {
+			// Fall back to the generic body for JSON syntax files
+			if err := graph.addJSONFile(file); err != nil {
+				return nil, err
+			}
 			continue
 		}
 
 		for _, block := range body.Blocks {
-			if block.Type == "module" && len(block.Labels) > 0 {
-				moduleName := block.Labels[0]
-
-				modules[moduleName] = ModuleInfo{
-					Name: moduleName,
+			switch {
+			case block.Type == "module" && len(block.Labels) > 0:
+				var attrs []*hcl.Attribute
+				for _, attr := range block.Body.Attributes {
+					attrs = append(attrs, attr.AsHCLAttribute())
+				}
+				graph.addModule(block.Labels[0], block.DefRange(), attrs)
+			case block.Type == "locals":
+				for name, attr := range block.Body.Attributes {
+					graph.locals[name] = attr.Expr
 				}
 			}
 		}
 	}
 
-	return modules, nil
+	return graph, nil
 }
 
-// buildDependencies builds dependency relationships between modules
-func (r *ModuleCircularDependencyRule) buildDependencies(runner tflint.Runner, modules map[string]ModuleInfo) ([]Dependency, error) {
-	var dependencies []Dependency
-	seenDeps := make(map[string]bool) // Map to prevent duplicates
-
-	files, err := runner.GetFiles()
+// addJSONFile extracts module blocks and local values from a file written in JSON syntax
+func (g *moduleGraph) addJSONFile(file *hcl.File) error {
+	blocks, err := jsonModuleBlocks(file)
 	if err != nil {
-		return nil, err
+		return err
+	}
+	for _, block := range blocks {
+		attrMap, diags := block.Body.JustAttributes()
+		if diags.HasErrors() {
+			return diags
+		}
+		var attrs []*hcl.Attribute
+		for _, attr := range attrMap {
+			attrs = append(attrs, attr)
+		}
+		g.addModule(block.Labels[0], block.DefRange, attrs)
 	}
 
-	// Sort by filename for deterministic order
-	var fileNames []string
-	for fileName := range files {
-		fileNames = append(fileNames, fileName)
+	content, _, diags := file.Body.PartialContent(&hcl.BodySchema{
+		Blocks: []hcl.BlockHeaderSchema{{Type: "locals"}},
+	})
+	if diags.HasErrors() {
+		return diags
+	}
+	for _, block := range content.Blocks {
+		attrs, diags := block.Body.JustAttributes()
+		if diags.HasErrors() {
+			return diags
+		}
+		for name, attr := range attrs {
+			g.locals[name] = attr.Expr
+		}
 	}
-	sort.Strings(fileNames)
 
-	for _, fileName := range fileNames {
-		file := files[fileName]
-		body, ok := file.Body.(*hclsyntax.Body)
-		if !ok {
+	return nil
+}
+
+// addModule records a module block with its attributes sorted by position
+func (g *moduleGraph) addModule(name string, defRange hcl.Range, attrs []*hcl.Attribute) {
+	// Sort attributes by position (by line number)
+	sort.Slice(attrs, func(i, j int) bool {
+		return attrs[i].Range.Start.Line < attrs[j].Range.Start.Line
+	})
+
+	g.blocks = append(g.blocks, moduleBlock{
+		Name:     name,
+		DefRange: defRange,
+		Attrs:    attrs,
+	})
+}
+
+// disabled returns whether the module is never instantiated, with a literal count = 0 or an empty for_each
+func (b moduleBlock) disabled() bool {
+	for _, attr := range b.Attrs {
+		if attr.Name != "count" && attr.Name != "for_each" {
 			continue
 		}
 
-		// Sort blocks for deterministic order
-		var blocks []*hclsyntax.Block
-		for _, block := range body.Blocks {
-			blocks = append(blocks, block)
+		val, diags := attr.Expr.Value(nil)
+		if diags.HasErrors() || val.IsNull() || !val.IsWhollyKnown() {
+			continue
 		}
+		switch {
+		case attr.Name == "count" && val.Type() == cty.Number:
+			if val.Equals(cty.Zero).True() {
+				return true
+			}
+		case attr.Name == "for_each" && val.CanIterateElements():
+			if val.LengthInt() == 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
 
-		// Sort blocks by position (by line number)
-		sort.Slice(blocks, func(i, j int) bool {
-			return blocks[i].Range().Start.Line < blocks[j].Range().Start.Line
-		})
-
-		for _, block := range blocks {
-			if block.Type == "module" && len(block.Labels) > 0 {
-				moduleName := block.Labels[0]
+// modules returns all module definitions by name
+func (g *moduleGraph) modules() map[string]ModuleInfo {
+	modules := make(map[string]ModuleInfo)
+	for _, block := range g.blocks {
+		modules[block.Name] = ModuleInfo{
+			Name:     block.Name,
+			DefRange: block.DefRange,
+		}
+	}
+	return modules
+}
 
-				// Sort attributes for deterministic order
-				var attrs []*hclsyntax.Attribute
-				for _, attr := range block.Body.Attributes {
-					attrs = append(attrs, attr)
-				}
+// dependencies builds dependency relationships between the given modules
+func (g *moduleGraph) dependencies(modules map[string]ModuleInfo) []Dependency {
+	var dependencies []Dependency
+	seenDeps := make(map[string]bool) // Map to prevent duplicates
 
-				// Sort attributes by position (by line number)
-				sort.Slice(attrs, func(i, j int) bool {
-					return attrs[i].Range().Start.Line < attrs[j].Range().Start.Line
-				})
+	for _, block := range g.blocks {
+		// Only record edges between registered modules
+		if _, exists := modules[block.Name]; !exists {
+			continue
+		}
 
-				for _, attr := range attrs {
-					deps := r.findModuleReferences(attr.Expr, modules)
-					for _, dep := range deps {
-						// Create key for duplicate checking
-						depKey := moduleName + "->" + dep
-						if !seenDeps[depKey] {
-							seenDeps[depKey] = true
-							dependencies = append(dependencies, Dependency{
-								From:  moduleName,
-								To:    dep,
-								Range: attr.Range(),
-							})
-						}
-					}
+		for _, attr := range block.Attrs {
+			deps := findModuleReferences(attr.Expr, modules)
+			deps = append(deps, localModuleReferences(attr.Expr, g.locals, modules, make(map[string]bool))...)
+			for _, dep := range deps {
+				// Create key for duplicate checking
+				depKey := block.Name + "->" + dep
+				if !seenDeps[depKey] {
+					seenDeps[depKey] = true
+					dependencies = append(dependencies, Dependency{
+						From:  block.Name,
+						To:    dep,
+						Range: attr.Range,
+					})
 				}
 			}
 		}
 	}
 
-	return dependencies, nil
+	return dependencies
 }
 
-// findModuleReferences searches for module references in expressions
-func (r *ModuleCircularDependencyRule) findModuleReferences(expr hcl.Expression, modules map[string]ModuleInfo) []string {
-	var references []string
+// nestedDependencies builds dependency relationships between the module calls inside local modules
+// Nested modules are named by their path from the root, e.g. "parent.child"
+// Each directory is read once, which bounds the recursion on local cycles and avoids reporting
+// the same nested cycle once per caller
+func (r *ModuleCircularDependencyRule) nestedDependencies(graph *moduleGraph, modules map[string]ModuleInfo) ([]Dependency, error) {
+	var dependencies []Dependency
+	visited := make(map[string]bool)
 
-	switch e := expr.(type) {
-	case *hclsyntax.ScopeTraversalExpr:
-		// Check format: module.module_name.output_name
-		if len(e.Traversal) >= 2 {
-			if root, ok := e.Traversal[0].(hcl.TraverseRoot); ok {
-				if root.Name == "module" && len(e.Traversal) >= 2 {
-					if attr, ok := e.Traversal[1].(hcl.TraverseAttr); ok {
-						if _, exists := modules[attr.Name]; exists {
-							references = append(references, attr.Name)
-						}
-					}
-				}
-			}
+	for _, block := range graph.blocks {
+		if _, exists := modules[block.Name]; !exists {
+			continue
 		}
-
-	case *hclsyntax.TemplateExpr:
-		// Check references in template expressions
-		for _, part := range e.Parts {
-			refs := r.findModuleReferences(part, modules)
-			references = append(references, refs...)
+		if err := r.collectNestedDependencies(block, block.Name, visited, &dependencies); err != nil {
+			return nil, err
 		}
+	}
 
-	case *hclsyntax.TupleConsExpr:
-		// Check references in tuple expressions
-		for _, expr := range e.Exprs {
-			refs := r.findModuleReferences(expr, modules)
-			references = append(references, refs...)
-		}
+	return dependencies, nil
+}
 
-	case *hclsyntax.ObjectConsExpr:
-		// Check references in object expressions
-		for _, item := range e.Items {
-			if item.ValueExpr != nil {
-				refs := r.findModuleReferences(item.ValueExpr, modules)
-				references = append(references, refs...)
-			}
-		}
+// collectNestedDependencies appends the dependencies inside the local module called by a block
+func (r *ModuleCircularDependencyRule) collectNestedDependencies(block moduleBlock, prefix string, visited map[string]bool, dependencies *[]Dependency) error {
+	dir, ok := block.localSourceDir()
+	if !ok || visited[dir] {
+		return nil
+	}
+	visited[dir] = true
 
-	case *hclsyntax.FunctionCallExpr:
-		// Check references in function calls
-		for _, arg := range e.Args {
-			refs := r.findModuleReferences(arg, modules)
-			references = append(references, refs...)
-		}
+	files, err := parseModuleDir(dir)
+	if err != nil {
+		return err
+	}
+	var fileNames []string
+	for name := range files {
+		fileNames = append(fileNames, name)
+	}
+	sort.Strings(fileNames)
+
+	nested, err := parseModuleGraph(files, fileNames)
+	if err != nil {
+		return err
+	}
 
-	case *hclsyntax.ConditionalExpr:
-		// Check references in conditional expressions
-		if e.TrueResult != nil {
-			refs := r.findModuleReferences(e.TrueResult, modules)
-			references = append(references, refs...)
+	for _, dep := range nested.dependencies(nested.modules()) {
+		*dependencies = append(*dependencies, Dependency{
+			From:  prefix + "." + dep.From,
+			To:    prefix + "." + dep.To,
+			Range: dep.Range,
+		})
+	}
+
+	for _, child := range nested.blocks {
+		if err := r.collectNestedDependencies(child, prefix+"."+child.Name, visited, dependencies); err != nil {
+			return err
 		}
-		if e.FalseResult != nil {
-			refs := r.findModuleReferences(e.FalseResult, modules)
-			references = append(references, refs...)
+	}
+
+	return nil
+}
+
+// localSourceDir returns the directory of a module block with a local source
+func (b moduleBlock) localSourceDir() (string, bool) {
+	for _, attr := range b.Attrs {
+		if attr.Name == "source" {
+			return localModuleDir(attr.Expr)
 		}
+	}
+	return "", false
+}
 
-	case *hclsyntax.ForExpr:
-		// Check references in for expressions
-		if e.CollExpr != nil {
-			refs := r.findModuleReferences(e.CollExpr, modules)
-			references = append(references, refs...)
+// localModuleReferences returns the modules referenced indirectly through local values used in an expression
+// Locals referencing other locals are followed, and visited guards against cycles between locals
+func localModuleReferences(expr hcl.Expression, locals map[string]hcl.Expression, modules map[string]ModuleInfo, visited map[string]bool) []string {
+	var references []string
+
+	for _, traversal := range expr.Variables() {
+		if len(traversal) < 2 || traversal.RootName() != "local" {
+			continue
 		}
-		if e.KeyExpr != nil {
-			refs := r.findModuleReferences(e.KeyExpr, modules)
-			references = append(references, refs...)
+		attr, ok := traversal[1].(hcl.TraverseAttr)
+		if !ok || visited[attr.Name] {
+			continue
 		}
-		if e.ValExpr != nil {
-			refs := r.findModuleReferences(e.ValExpr, modules)
-			references = append(references, refs...)
+		localExpr, exists := locals[attr.Name]
+		if !exists {
+			continue
 		}
-		if e.CondExpr != nil {
-			refs := r.findModuleReferences(e.CondExpr, modules)
-			references = append(references, refs...)
+		visited[attr.Name] = true
+
+		references = append(references, findModuleReferences(localExpr, modules)...)
+		references = append(references, localModuleReferences(localExpr, locals, modules, visited)...)
+	}
+
+	return references
+}
+
+// jsonModuleBlocks extracts module blocks from a file written in JSON syntax
+func jsonModuleBlocks(file *hcl.File) ([]*hcl.Block, error) {
+	content, _, diags := file.Body.PartialContent(&hcl.BodySchema{
+		Blocks: []hcl.BlockHeaderSchema{
+			{Type: "module", LabelNames: []string{"name"}},
+		},
+	})
+	if diags.HasErrors() {
+		return nil, diags
+	}
+
+	// Sort blocks by position (by line number)
+	blocks := content.Blocks
+	sort.SliceStable(blocks, func(i, j int) bool {
+		return blocks[i].DefRange.Start.Line < blocks[j].DefRange.Start.Line
+	})
+
+	return blocks, nil
+}
+
+// moduleNameFromTraversal returns the module name of a traversal rooted at module
+// Instance keys of count/for_each modules (module.module_name["key"].output) are skipped over
+func moduleNameFromTraversal(traversal hcl.Traversal) (string, bool) {
+	return referenceNameFromTraversal(traversal, "module")
+}
+
+// referenceNameFromTraversal returns the name referenced by a traversal rooted at rootName
+// e.g. "module_name" for module.module_name.output or "foo" for local.foo
+func referenceNameFromTraversal(traversal hcl.Traversal, rootName string) (string, bool) {
+	if len(traversal) < 2 || traversal.RootName() != rootName {
+		return "", false
+	}
+
+	// Only the step right after the root names the referenced object; any steps after it
+	// (instance keys, output names, attributes) do not affect the reference
+	if attr, ok := traversal[1].(hcl.TraverseAttr); ok {
+		return attr.Name, true
+	}
+	return "", false
+}
+
+// findModuleReferences searches for references to the given modules in expressions
+func findModuleReferences(expr hcl.Expression, modules map[string]ModuleInfo) []string {
+	var references []string
+	for _, name := range findReferences(expr, "module") {
+		if _, exists := modules[name]; exists {
+			references = append(references, name)
 		}
 	}
+	return references
+}
 
+// findReferences searches expressions for traversals rooted at rootName (e.g. module, local, var)
+// and returns the referenced names
+// A bare root.name (e.g. module.module_name as used in depends_on) also counts as a reference
+func findReferences(expr hcl.Expression, rootName string) []string {
+	var references []string
+	for _, traversal := range collectTraversals(expr, rootName) {
+		if name, ok := referenceNameFromTraversal(traversal, rootName); ok {
+			references = append(references, name)
+		}
+	}
 	return references
 }
 
 // detectCircularDependencies detects circular dependencies
-func (r *ModuleCircularDependencyRule) detectCircularDependencies(dependencies []Dependency) []CircularDependency {
+func (r *ModuleCircularDependencyRule) detectCircularDependencies(dependencies []Dependency, config moduleCircularDependencyRuleConfig) []CircularDependency {
 	var circularDeps []CircularDependency
 	reportedCycles := make(map[string]bool) // Track reported cycles
 
+	// Build keys of cycles whitelisted via ignore_cycles
+	ignoredCycles := make(map[string]bool)
+	for _, entry := range config.IgnoreCycles {
+		var names []string
+		for _, name := range strings.Split(entry, ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				names = append(names, name)
+			}
+		}
+		ignoredCycles[r.cycleSetKey(names)] = true
+	}
+
 	// Build dependency map
-	depMap := make(map[string][]string)
+	depMap := r.dependencyMap(dependencies)
 	depRangeMap := make(map[string]map[string]hcl.Range)
 	for _, dep := range dependencies {
-		depMap[dep.From] = append(depMap[dep.From], dep.To)
+		// Self-references are reported separately and kept out of the graph
+		if dep.From == dep.To {
+			if ignoredCycles[r.cycleSetKey([]string{dep.From})] {
+				continue
+			}
+			circularDeps = append(circularDeps, CircularDependency{
+				ModuleA: dep.From,
+				ModuleB: dep.To,
+				Range:   dep.Range,
+				Cycle:   []string{dep.From},
+			})
+			continue
+		}
+
 		if depRangeMap[dep.From] == nil {
 			depRangeMap[dep.From] = make(map[string]hcl.Range)
 		}
@@ -315,11 +717,6 @@ func (r *ModuleCircularDependencyRule) detectCircularDependencies(dependencies [
 	}
 	sort.Strings(modules)
 
-	// Sort dependencies for deterministic order
-	for from := range depMap {
-		sort.Strings(depMap[from])
-	}
-
 	// First detect direct circular dependencies (A → B → A)
 	for _, module := range modules {
 		if deps, exists := depMap[module]; exists {
@@ -331,6 +728,11 @@ func (r *ModuleCircularDependencyRule) detectCircularDependencies(dependencies [
 							// Found direct circular dependency
 							cycleKey := r.normalizeCycle([]string{module, dep})
 
+							// Skip cycles whitelisted via ignore_cycles
+							if ignoredCycles[r.cycleSetKey([]string{module, dep})] {
+								continue
+							}
+
 							// Check if cycle already reported
 							if reportedCycles[cycleKey] {
 								continue
@@ -343,10 +745,17 @@ func (r *ModuleCircularDependencyRule) detectCircularDependencies(dependencies [
 								rangeToUse = depRangeMap[module][dep]
 							}
 
+							secondaryRange := hcl.Range{}
+							if depRangeMap[dep] != nil && depRangeMap[dep][module].Filename != "" {
+								secondaryRange = depRangeMap[dep][module]
+							}
+
 							circularDeps = append(circularDeps, CircularDependency{
-								ModuleA: module,
-								ModuleB: dep,
-								Range:   rangeToUse,
+								ModuleA:        module,
+								ModuleB:        dep,
+								Range:          rangeToUse,
+								SecondaryRange: secondaryRange,
+								Cycle:          []string{module, dep},
 							})
 						}
 					}
@@ -362,10 +771,19 @@ func (r *ModuleCircularDependencyRule) detectCircularDependencies(dependencies [
 		path := []string{}
 
 		// Detect circular dependency (only the first one found)
-		if cycle := r.findCycle(module, depMap, visited, recStack, &path); cycle != nil {
+		if found := r.findCycle(module, depMap, visited, recStack, &path); found != nil {
+			// Copy the cycle since it shares memory with path
+			cycle := make([]string, len(found))
+			copy(cycle, found)
+
 			// Create unique key for cycle (normalize order)
 			cycleKey := r.normalizeCycle(cycle)
 
+			// Skip cycles whitelisted via ignore_cycles
+			if ignoredCycles[r.cycleSetKey(cycle)] {
+				continue
+			}
+
 			// Check if cycle already reported
 			if reportedCycles[cycleKey] {
 				continue
@@ -386,27 +804,101 @@ func (r *ModuleCircularDependencyRule) detectCircularDependencies(dependencies [
 
 				// Include entire cycle path in message
 				cyclePath := ""
-				for j, mod := range cycle {
-					if j > 0 {
-						cyclePath += " → "
-					}
-					cyclePath += mod
+				if config.MaxCycleLength > 0 && len(cycle) > config.MaxCycleLength {
+					// Summarize cycles longer than the configured threshold
+					cyclePath = fmt.Sprintf("cycle of %d modules", len(cycle))
+				} else {
+					cyclePath = r.formatCyclePath(cycle)
 				}
-				cyclePath += " → " + cycle[0] // Return to first module
 
 				circularDeps = append(circularDeps, CircularDependency{
 					ModuleA:   moduleA,
 					ModuleB:   moduleB,
 					Range:     rangeToUse,
 					CyclePath: cyclePath, // Add entire cycle path
+					Cycle:     cycle,
 				})
 			}
 		}
 	}
 
+	// Sort by normalized cycle key for a fully deterministic global order
+	// Stable sort keeps the edge order within each cycle
+	sort.SliceStable(circularDeps, func(i, j int) bool {
+		return r.normalizeCycle(circularDeps[i].Cycle) < r.normalizeCycle(circularDeps[j].Cycle)
+	})
+
 	return circularDeps
 }
 
+// dependencyMap builds a sorted adjacency list of module dependencies, excluding self-references
+func (r *ModuleCircularDependencyRule) dependencyMap(dependencies []Dependency) map[string][]string {
+	depMap := make(map[string][]string)
+	for _, dep := range dependencies {
+		if dep.From == dep.To {
+			continue
+		}
+		depMap[dep.From] = append(depMap[dep.From], dep.To)
+	}
+
+	// Sort dependencies for deterministic order
+	for from := range depMap {
+		sort.Strings(depMap[from])
+	}
+
+	return depMap
+}
+
+// dependencyDepths returns the length of the longest dependency chain from each root module
+// Root modules are those that no other module depends on. Back-edges are skipped so cycles do not loop forever
+func (r *ModuleCircularDependencyRule) dependencyDepths(depMap map[string][]string) map[string]int {
+	dependedOn := make(map[string]bool)
+	for _, deps := range depMap {
+		for _, dep := range deps {
+			dependedOn[dep] = true
+		}
+	}
+
+	memo := make(map[string]int)
+	onStack := make(map[string]bool)
+	var longest func(module string) int
+	longest = func(module string) int {
+		if depth, ok := memo[module]; ok {
+			return depth
+		}
+		onStack[module] = true
+		depth := 0
+		for _, dep := range depMap[module] {
+			if onStack[dep] {
+				continue
+			}
+			if d := longest(dep) + 1; d > depth {
+				depth = d
+			}
+		}
+		onStack[module] = false
+		memo[module] = depth
+		return depth
+	}
+
+	depths := make(map[string]int)
+	for module := range depMap {
+		if !dependedOn[module] {
+			depths[module] = longest(module)
+		}
+	}
+
+	return depths
+}
+
+// formatCyclePath formats a cycle as a path returning to the first module
+func (r *ModuleCircularDependencyRule) formatCyclePath(cycle []string) string {
+	if len(cycle) == 0 {
+		return ""
+	}
+	return strings.Join(cycle, " → ") + " → " + cycle[0]
+}
+
 // normalizeCycle normalizes a cycle to create a unique key
 func (r *ModuleCircularDependencyRule) normalizeCycle(cycle []string) string {
 	if len(cycle) == 0 {
@@ -435,38 +927,74 @@ func (r *ModuleCircularDependencyRule) normalizeCycle(cycle []string) string {
 	return result
 }
 
+// cycleSetKey creates a key for the set of modules in a cycle regardless of their order
+func (r *ModuleCircularDependencyRule) cycleSetKey(cycle []string) string {
+	sorted := make([]string, len(cycle))
+	copy(sorted, cycle)
+	sort.Strings(sorted)
+	return r.normalizeCycle(sorted)
+}
+
 // findCycle detects circular dependencies using depth-first search and returns the cycle
+// The search uses an explicit stack so that deep dependency chains cannot overflow the goroutine stack
 func (r *ModuleCircularDependencyRule) findCycle(module string, depMap map[string][]string, visited map[string]bool, recStack map[string]bool, path *[]string) []string {
 	if recStack[module] {
-		// Found circular dependency - find the start of the cycle
-		cycleStart := -1
-		for i, m := range *path {
-			if m == module {
-				cycleStart = i
-				break
-			}
-		}
-		if cycleStart >= 0 {
-			return (*path)[cycleStart:]
-		}
-		return nil
+		return r.cycleFromPath(module, *path)
 	}
 
 	if visited[module] {
 		return nil
 	}
 
+	// dfsFrame tracks a module on the stack and the index of its next dependency to visit
+	type dfsFrame struct {
+		module string
+		next   int
+	}
+
 	visited[module] = true
 	recStack[module] = true
 	*path = append(*path, module)
+	stack := []dfsFrame{{module: module}}
+
+	for len(stack) > 0 {
+		top := &stack[len(stack)-1]
+		deps := depMap[top.module]
+
+		if top.next < len(deps) {
+			dep := deps[top.next]
+			top.next++
+
+			if recStack[dep] {
+				// Found circular dependency
+				return r.cycleFromPath(dep, *path)
+			}
+			if visited[dep] {
+				continue
+			}
 
-	for _, dep := range depMap[module] {
-		if cycle := r.findCycle(dep, depMap, visited, recStack, path); cycle != nil {
-			return cycle
+			visited[dep] = true
+			recStack[dep] = true
+			*path = append(*path, dep)
+			stack = append(stack, dfsFrame{module: dep})
+			continue
 		}
+
+		// All dependencies visited - leave the module
+		recStack[top.module] = false
+		*path = (*path)[:len(*path)-1]
+		stack = stack[:len(stack)-1]
 	}
 
-	recStack[module] = false
-	*path = (*path)[:len(*path)-1]
+	return nil
+}
+
+// cycleFromPath returns the part of the path starting at the module where the cycle begins
+func (r *ModuleCircularDependencyRule) cycleFromPath(module string, path []string) []string {
+	for i, m := range path {
+		if m == module {
+			return path[i:]
+		}
+	}
 	return nil
 }
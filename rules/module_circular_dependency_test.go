@@ -1,15 +1,24 @@
 package rules
 
 import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclparse"
 	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
 )
 
 func TestModuleCircularDependencyRule(t *testing.T) {
 	tests := []struct {
 		name     string
 		content  string
+		config   string
 		expected helper.Issues
 	}{
 		{
@@ -46,7 +55,11 @@ module "module_b" {
 			expected: helper.Issues{
 				{
 					Rule:    NewModuleCircularDependencyRule(),
-					Message: "Circular dependency detected between modules: module_a ↔ module_b",
+					Message: "Circular dependency detected between modules: module_a ↔ module_b (see https://github.com/takaishi/tflint-ruleset-takaishi#direct-cycles)",
+				},
+				{
+					Rule:    NewModuleCircularDependencyRule(),
+					Message: "Circular dependency detected between modules: module_b ↔ module_a (see https://github.com/takaishi/tflint-ruleset-takaishi#direct-cycles)",
 				},
 			},
 		},
@@ -65,7 +78,11 @@ module "module_b" {
 			expected: helper.Issues{
 				{
 					Rule:    NewModuleCircularDependencyRule(),
-					Message: "Circular dependency detected between modules: module_a ↔ module_b",
+					Message: "Circular dependency detected between modules: module_a ↔ module_b (see https://github.com/takaishi/tflint-ruleset-takaishi#direct-cycles)",
+				},
+				{
+					Rule:    NewModuleCircularDependencyRule(),
+					Message: "Circular dependency detected between modules: module_b ↔ module_a (see https://github.com/takaishi/tflint-ruleset-takaishi#direct-cycles)",
 				},
 			},
 		},
@@ -88,7 +105,11 @@ module "module_b" {
 			expected: helper.Issues{
 				{
 					Rule:    NewModuleCircularDependencyRule(),
-					Message: "Circular dependency detected between modules: module_a ↔ module_b",
+					Message: "Circular dependency detected between modules: module_a ↔ module_b (see https://github.com/takaishi/tflint-ruleset-takaishi#direct-cycles)",
+				},
+				{
+					Rule:    NewModuleCircularDependencyRule(),
+					Message: "Circular dependency detected between modules: module_b ↔ module_a (see https://github.com/takaishi/tflint-ruleset-takaishi#direct-cycles)",
 				},
 			},
 		},
@@ -107,7 +128,11 @@ module "module_b" {
 			expected: helper.Issues{
 				{
 					Rule:    NewModuleCircularDependencyRule(),
-					Message: "Circular dependency detected between modules: module_a ↔ module_b",
+					Message: "Circular dependency detected between modules: module_a ↔ module_b (see https://github.com/takaishi/tflint-ruleset-takaishi#direct-cycles)",
+				},
+				{
+					Rule:    NewModuleCircularDependencyRule(),
+					Message: "Circular dependency detected between modules: module_b ↔ module_a (see https://github.com/takaishi/tflint-ruleset-takaishi#direct-cycles)",
 				},
 			},
 		},
@@ -151,7 +176,11 @@ module "module_b" {
 			expected: helper.Issues{
 				{
 					Rule:    NewModuleCircularDependencyRule(),
-					Message: "Circular dependency detected between modules: module_a ↔ module_b",
+					Message: "Circular dependency detected between modules: module_a ↔ module_b (see https://github.com/takaishi/tflint-ruleset-takaishi#direct-cycles)",
+				},
+				{
+					Rule:    NewModuleCircularDependencyRule(),
+					Message: "Circular dependency detected between modules: module_b ↔ module_a (see https://github.com/takaishi/tflint-ruleset-takaishi#direct-cycles)",
 				},
 			},
 		},
@@ -175,64 +204,1620 @@ module "module_c" {
 			expected: helper.Issues{
 				{
 					Rule:    NewModuleCircularDependencyRule(),
-					Message: "Circular dependency detected between modules: module_a ↔ module_b (path: module_a → module_b → module_c → module_a)",
+					Message: "Circular dependency detected between modules: module_a ↔ module_b (path: module_a → module_b → module_c → module_a) (see https://github.com/takaishi/tflint-ruleset-takaishi#indirect-cycles)",
 				},
 				{
 					Rule:    NewModuleCircularDependencyRule(),
-					Message: "Circular dependency detected between modules: module_b ↔ module_c (path: module_a → module_b → module_c → module_a)",
+					Message: "Circular dependency detected between modules: module_b ↔ module_c (path: module_a → module_b → module_c → module_a) (see https://github.com/takaishi/tflint-ruleset-takaishi#indirect-cycles)",
 				},
 				{
 					Rule:    NewModuleCircularDependencyRule(),
-					Message: "Circular dependency detected between modules: module_c ↔ module_a (path: module_a → module_b → module_c → module_a)",
+					Message: "Circular dependency detected between modules: module_c ↔ module_a (path: module_a → module_b → module_c → module_a) (see https://github.com/takaishi/tflint-ruleset-takaishi#indirect-cycles)",
 				},
 			},
 		},
-	}
+		{
+			name: "self-referencing module",
+			content: `
+module "module_a" {
+  source = "./modules/a"
+  input = module.module_a.output
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewModuleCircularDependencyRule(),
+					Message: "Self-referencing module detected: module_a",
+				},
+			},
+		},
+		{
+			name: "self-referencing module inside a cycle",
+			content: `
+module "module_a" {
+  source = "./modules/a"
+  input1 = module.module_a.output
+  input2 = module.module_b.output
+}
 
-	rule := NewModuleCircularDependencyRule()
+module "module_b" {
+  source = "./modules/b"
+  input = module.module_a.output
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewModuleCircularDependencyRule(),
+					Message: "Self-referencing module detected: module_a",
+				},
+				{
+					Rule:    NewModuleCircularDependencyRule(),
+					Message: "Circular dependency detected between modules: module_a ↔ module_b (see https://github.com/takaishi/tflint-ruleset-takaishi#direct-cycles)",
+				},
+				{
+					Rule:    NewModuleCircularDependencyRule(),
+					Message: "Circular dependency detected between modules: module_b ↔ module_a (see https://github.com/takaishi/tflint-ruleset-takaishi#direct-cycles)",
+				},
+			},
+		},
+		{
+			name: "circular dependency through index expressions",
+			content: `
+module "module_a" {
+  source = "./modules/a"
+  input = module.module_b.outputs[0]
+}
 
-	for _, test := range tests {
-		t.Run(test.name, func(t *testing.T) {
-			runner := helper.TestRunner(t, map[string]string{"main.tf": test.content})
-			if err := rule.Check(runner); err != nil {
-				t.Fatalf("Unexpected error occurred: %s", err)
-			}
+module "module_b" {
+  source = "./modules/b"
+  input = module.module_a.outputs[local.index]
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewModuleCircularDependencyRule(),
+					Message: "Circular dependency detected between modules: module_a ↔ module_b (see https://github.com/takaishi/tflint-ruleset-takaishi#direct-cycles)",
+				},
+				{
+					Rule:    NewModuleCircularDependencyRule(),
+					Message: "Circular dependency detected between modules: module_b ↔ module_a (see https://github.com/takaishi/tflint-ruleset-takaishi#direct-cycles)",
+				},
+			},
+		},
+		{
+			name: "circular dependency through index keys with three modules",
+			content: `
+module "module_a" {
+  source = "./modules/a"
+  input = local.values[module.module_b.key]
+}
 
-			// Check expected error count
-			if len(runner.Issues) != len(test.expected) {
-				t.Errorf("Expected %d issues, got %d", len(test.expected), len(runner.Issues))
-				for _, issue := range runner.Issues {
-					t.Logf("Issue: %s", issue.Message)
-				}
-				return
-			}
+module "module_b" {
+  source = "./modules/b"
+  input = module.module_c.outputs[0]
+}
 
-			// Check if circular dependency error message is included
-			for i, expectedIssue := range test.expected {
-				if i >= len(runner.Issues) {
-					break
-				}
-				actualIssue := runner.Issues[i]
+module "module_c" {
+  source = "./modules/c"
+  input = module.module_a.outputs["name"]
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewModuleCircularDependencyRule(),
+					Message: "Circular dependency detected between modules: module_a ↔ module_b (path: module_a → module_b → module_c → module_a) (see https://github.com/takaishi/tflint-ruleset-takaishi#indirect-cycles)",
+				},
+				{
+					Rule:    NewModuleCircularDependencyRule(),
+					Message: "Circular dependency detected between modules: module_b ↔ module_c (path: module_a → module_b → module_c → module_a) (see https://github.com/takaishi/tflint-ruleset-takaishi#indirect-cycles)",
+				},
+				{
+					Rule:    NewModuleCircularDependencyRule(),
+					Message: "Circular dependency detected between modules: module_c ↔ module_a (path: module_a → module_b → module_c → module_a) (see https://github.com/takaishi/tflint-ruleset-takaishi#indirect-cycles)",
+				},
+			},
+		},
+		{
+			name: "circular dependency through splat expression",
+			content: `
+module "module_a" {
+  source = "./modules/a"
+  ids = module.module_b.instances[*].id
+}
 
-				// Check if message contains "Circular dependency detected between modules"
-				if expectedIssue.Message != "" {
-					// Allow either module_a ↔ module_b or module_b ↔ module_a
-					containsCircular := false
-					if actualIssue.Message == expectedIssue.Message {
-						containsCircular = true
-					} else {
-						// Allow reverse order message
-						reverseMsg := "Circular dependency detected between modules: module_b ↔ module_a"
-						if expectedIssue.Message == "Circular dependency detected between modules: module_a ↔ module_b" && actualIssue.Message == reverseMsg {
-							containsCircular = true
-						}
-					}
+module "module_b" {
+  source = "./modules/b"
+  input = module.module_a.output
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewModuleCircularDependencyRule(),
+					Message: "Circular dependency detected between modules: module_a ↔ module_b (see https://github.com/takaishi/tflint-ruleset-takaishi#direct-cycles)",
+				},
+				{
+					Rule:    NewModuleCircularDependencyRule(),
+					Message: "Circular dependency detected between modules: module_b ↔ module_a (see https://github.com/takaishi/tflint-ruleset-takaishi#direct-cycles)",
+				},
+			},
+		},
+		{
+			name: "circular dependency through binary operator expressions",
+			content: `
+module "module_a" {
+  source = "./modules/a"
+  enabled = module.module_b.a == module.module_c.b
+}
 
-					if !containsCircular {
-						t.Errorf("Expected message '%s', got '%s'", expectedIssue.Message, actualIssue.Message)
-					}
-				}
-			}
+module "module_b" {
+  source = "./modules/b"
+  size = module.module_a.count + 1
+}
+
+module "module_c" {
+  source = "./modules/c"
+  input = "value"
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewModuleCircularDependencyRule(),
+					Message: "Circular dependency detected between modules: module_a ↔ module_b (see https://github.com/takaishi/tflint-ruleset-takaishi#direct-cycles)",
+				},
+				{
+					Rule:    NewModuleCircularDependencyRule(),
+					Message: "Circular dependency detected between modules: module_b ↔ module_a (see https://github.com/takaishi/tflint-ruleset-takaishi#direct-cycles)",
+				},
+			},
+		},
+		{
+			name: "circular dependency through comparison on the right-hand side",
+			content: `
+module "module_a" {
+  source = "./modules/a"
+  enabled = module.module_c.a == module.module_b.b
+}
+
+module "module_b" {
+  source = "./modules/b"
+  input = "value"
+}
+
+module "module_c" {
+  source = "./modules/c"
+  enabled = var.flag && module.module_a.enabled
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewModuleCircularDependencyRule(),
+					Message: "Circular dependency detected between modules: module_a ↔ module_c (see https://github.com/takaishi/tflint-ruleset-takaishi#direct-cycles)",
+				},
+				{
+					Rule:    NewModuleCircularDependencyRule(),
+					Message: "Circular dependency detected between modules: module_c ↔ module_a (see https://github.com/takaishi/tflint-ruleset-takaishi#direct-cycles)",
+				},
+			},
+		},
+		{
+			name: "circular dependency through relative traversal",
+			content: `
+module "module_a" {
+  source = "./modules/a"
+  input = tomap(module.module_b.settings).name
+}
+
+module "module_b" {
+  source = "./modules/b"
+  input = module.module_a.output
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewModuleCircularDependencyRule(),
+					Message: "Circular dependency detected between modules: module_a ↔ module_b (see https://github.com/takaishi/tflint-ruleset-takaishi#direct-cycles)",
+				},
+				{
+					Rule:    NewModuleCircularDependencyRule(),
+					Message: "Circular dependency detected between modules: module_b ↔ module_a (see https://github.com/takaishi/tflint-ruleset-takaishi#direct-cycles)",
+				},
+			},
+		},
+		{
+			name: "circular dependency through parenthesized expressions",
+			content: `
+module "module_a" {
+  source = "./modules/a"
+  input = (module.module_b.output)
+}
+
+module "module_b" {
+  source = "./modules/b"
+  input = ((module.module_a.output))
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewModuleCircularDependencyRule(),
+					Message: "Circular dependency detected between modules: module_a ↔ module_b (see https://github.com/takaishi/tflint-ruleset-takaishi#direct-cycles)",
+				},
+				{
+					Rule:    NewModuleCircularDependencyRule(),
+					Message: "Circular dependency detected between modules: module_b ↔ module_a (see https://github.com/takaishi/tflint-ruleset-takaishi#direct-cycles)",
+				},
+			},
+		},
+		{
+			name: "cycle longer than max_cycle_length is summarized",
+			content: `
+module "module_a" {
+  source = "./modules/a"
+  input = module.module_b.output
+}
+
+module "module_b" {
+  source = "./modules/b"
+  input = module.module_c.output
+}
+
+module "module_c" {
+  source = "./modules/c"
+  input = module.module_a.output
+}`,
+			config: `
+rule "module_circular_dependency" {
+  enabled = true
+  max_cycle_length = 2
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewModuleCircularDependencyRule(),
+					Message: "Circular dependency detected between modules: module_a ↔ module_b (path: cycle of 3 modules) (see https://github.com/takaishi/tflint-ruleset-takaishi#indirect-cycles)",
+				},
+				{
+					Rule:    NewModuleCircularDependencyRule(),
+					Message: "Circular dependency detected between modules: module_b ↔ module_c (path: cycle of 3 modules) (see https://github.com/takaishi/tflint-ruleset-takaishi#indirect-cycles)",
+				},
+				{
+					Rule:    NewModuleCircularDependencyRule(),
+					Message: "Circular dependency detected between modules: module_c ↔ module_a (path: cycle of 3 modules) (see https://github.com/takaishi/tflint-ruleset-takaishi#indirect-cycles)",
+				},
+			},
+		},
+		{
+			name: "cycle within max_cycle_length shows full path",
+			content: `
+module "module_a" {
+  source = "./modules/a"
+  input = module.module_b.output
+}
+
+module "module_b" {
+  source = "./modules/b"
+  input = module.module_c.output
+}
+
+module "module_c" {
+  source = "./modules/c"
+  input = module.module_a.output
+}`,
+			config: `
+rule "module_circular_dependency" {
+  enabled = true
+  max_cycle_length = 3
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewModuleCircularDependencyRule(),
+					Message: "Circular dependency detected between modules: module_a ↔ module_b (path: module_a → module_b → module_c → module_a) (see https://github.com/takaishi/tflint-ruleset-takaishi#indirect-cycles)",
+				},
+				{
+					Rule:    NewModuleCircularDependencyRule(),
+					Message: "Circular dependency detected between modules: module_b ↔ module_c (path: module_a → module_b → module_c → module_a) (see https://github.com/takaishi/tflint-ruleset-takaishi#indirect-cycles)",
+				},
+				{
+					Rule:    NewModuleCircularDependencyRule(),
+					Message: "Circular dependency detected between modules: module_c ↔ module_a (path: module_a → module_b → module_c → module_a) (see https://github.com/takaishi/tflint-ruleset-takaishi#indirect-cycles)",
+				},
+			},
+		},
+		{
+			name: "whitelisted cycle is ignored",
+			content: `
+module "module_a" {
+  source = "./modules/a"
+  input = module.module_b.output
+}
+
+module "module_b" {
+  source = "./modules/b"
+  input = module.module_a.output
+}
+
+module "module_c" {
+  source = "./modules/c"
+  input = module.module_d.output
+}
+
+module "module_d" {
+  source = "./modules/d"
+  input = module.module_c.output
+}`,
+			config: `
+rule "module_circular_dependency" {
+  enabled = true
+  ignore_cycles = ["module_b, module_a"]
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewModuleCircularDependencyRule(),
+					Message: "Circular dependency detected between modules: module_c ↔ module_d (see https://github.com/takaishi/tflint-ruleset-takaishi#direct-cycles)",
+				},
+				{
+					Rule:    NewModuleCircularDependencyRule(),
+					Message: "Circular dependency detected between modules: module_d ↔ module_c (see https://github.com/takaishi/tflint-ruleset-takaishi#direct-cycles)",
+				},
+			},
+		},
+		{
+			name: "whitelisted indirect cycle is ignored",
+			content: `
+module "module_a" {
+  source = "./modules/a"
+  input = module.module_b.output
+}
+
+module "module_b" {
+  source = "./modules/b"
+  input = module.module_c.output
+}
+
+module "module_c" {
+  source = "./modules/c"
+  input = module.module_a.output
+}`,
+			config: `
+rule "module_circular_dependency" {
+  enabled = true
+  ignore_cycles = ["module_c,module_b,module_a"]
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "circular dependency through depends_on",
+			content: `
+module "module_a" {
+  source = "./modules/a"
+  depends_on = [module.module_b]
+}
+
+module "module_b" {
+  source = "./modules/b"
+  depends_on = [module.module_a]
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewModuleCircularDependencyRule(),
+					Message: "Circular dependency detected between modules: module_a ↔ module_b (see https://github.com/takaishi/tflint-ruleset-takaishi#direct-cycles)",
+				},
+				{
+					Rule:    NewModuleCircularDependencyRule(),
+					Message: "Circular dependency detected between modules: module_b ↔ module_a (see https://github.com/takaishi/tflint-ruleset-takaishi#direct-cycles)",
+				},
+			},
+		},
+		{
+			name: "circular dependency through depends_on and attribute reference",
+			content: `
+module "module_a" {
+  source = "./modules/a"
+  depends_on = [module.module_b, aws_s3_bucket.this]
+}
+
+module "module_b" {
+  source = "./modules/b"
+  input = module.module_a.output
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewModuleCircularDependencyRule(),
+					Message: "Circular dependency detected between modules: module_a ↔ module_b (see https://github.com/takaishi/tflint-ruleset-takaishi#direct-cycles)",
+				},
+				{
+					Rule:    NewModuleCircularDependencyRule(),
+					Message: "Circular dependency detected between modules: module_b ↔ module_a (see https://github.com/takaishi/tflint-ruleset-takaishi#direct-cycles)",
+				},
+			},
+		},
+		{
+			name: "collapsed indirect cycle",
+			content: `
+module "module_a" {
+  source = "./modules/a"
+  input = module.module_b.output
+}
+
+module "module_b" {
+  source = "./modules/b"
+  input = module.module_c.output
+}
+
+module "module_c" {
+  source = "./modules/c"
+  input = module.module_a.output
+}`,
+			config: `
+rule "module_circular_dependency" {
+  enabled = true
+  collapse_cycles = true
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewModuleCircularDependencyRule(),
+					Message: "Circular dependency: module_a → module_b → module_c → module_a (see https://github.com/takaishi/tflint-ruleset-takaishi#indirect-cycles)",
+				},
+			},
+		},
+		{
+			name: "collapsed direct cycle",
+			content: `
+module "module_a" {
+  source = "./modules/a"
+  input = module.module_b.output
+}
+
+module "module_b" {
+  source = "./modules/b"
+  input = module.module_a.output
+}`,
+			config: `
+rule "module_circular_dependency" {
+  enabled = true
+  collapse_cycles = true
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewModuleCircularDependencyRule(),
+					Message: "Circular dependency: module_a → module_b → module_a (see https://github.com/takaishi/tflint-ruleset-takaishi#direct-cycles)",
+				},
+			},
+		},
+		{
+			name: "circular dependency between for_each modules",
+			content: `
+module "module_a" {
+  source = "./modules/a"
+  for_each = toset(["x", "y"])
+  input = module.module_b["key"].output
+}
+
+module "module_b" {
+  source = "./modules/b"
+  for_each = toset(["key"])
+  input = module.module_a[each.key].output
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewModuleCircularDependencyRule(),
+					Message: "Circular dependency detected between modules: module_a ↔ module_b (see https://github.com/takaishi/tflint-ruleset-takaishi#direct-cycles)",
+				},
+				{
+					Rule:    NewModuleCircularDependencyRule(),
+					Message: "Circular dependency detected between modules: module_b ↔ module_a (see https://github.com/takaishi/tflint-ruleset-takaishi#direct-cycles)",
+				},
+			},
+		},
+		{
+			name: "circular dependency between count modules",
+			content: `
+module "module_a" {
+  source = "./modules/a"
+  count = 2
+  input = module.module_b[0].output
+}
+
+module "module_b" {
+  source = "./modules/b"
+  input = module.module_a[count.index].output
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewModuleCircularDependencyRule(),
+					Message: "Circular dependency detected between modules: module_a ↔ module_b (see https://github.com/takaishi/tflint-ruleset-takaishi#direct-cycles)",
+				},
+				{
+					Rule:    NewModuleCircularDependencyRule(),
+					Message: "Circular dependency detected between modules: module_b ↔ module_a (see https://github.com/takaishi/tflint-ruleset-takaishi#direct-cycles)",
+				},
+			},
+		},
+		{
+			name: "long cycle includes refactor hint",
+			content: `
+module "module_a" {
+  source = "./modules/a"
+  input = module.module_b.output
+}
+
+module "module_b" {
+  source = "./modules/b"
+  input = module.module_c.output
+}
+
+module "module_c" {
+  source = "./modules/c"
+  input = module.module_d.output
+}
+
+module "module_d" {
+  source = "./modules/d"
+  input = module.module_e.output
+}
+
+module "module_e" {
+  source = "./modules/e"
+  input = module.module_f.output
+}
+
+module "module_f" {
+  source = "./modules/f"
+  input = module.module_a.output
+}`,
+			config: `
+rule "module_circular_dependency" {
+  enabled = true
+  long_cycle_threshold = 5
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewModuleCircularDependencyRule(),
+					Message: "Circular dependency detected between modules: module_a ↔ module_b (path: module_a → module_b → module_c → module_d → module_e → module_f → module_a) (cycle spans 6 modules; consider introducing a shared data module) (see https://github.com/takaishi/tflint-ruleset-takaishi#indirect-cycles)",
+				},
+				{
+					Rule:    NewModuleCircularDependencyRule(),
+					Message: "Circular dependency detected between modules: module_b ↔ module_c (path: module_a → module_b → module_c → module_d → module_e → module_f → module_a) (cycle spans 6 modules; consider introducing a shared data module) (see https://github.com/takaishi/tflint-ruleset-takaishi#indirect-cycles)",
+				},
+				{
+					Rule:    NewModuleCircularDependencyRule(),
+					Message: "Circular dependency detected between modules: module_c ↔ module_d (path: module_a → module_b → module_c → module_d → module_e → module_f → module_a) (cycle spans 6 modules; consider introducing a shared data module) (see https://github.com/takaishi/tflint-ruleset-takaishi#indirect-cycles)",
+				},
+				{
+					Rule:    NewModuleCircularDependencyRule(),
+					Message: "Circular dependency detected between modules: module_d ↔ module_e (path: module_a → module_b → module_c → module_d → module_e → module_f → module_a) (cycle spans 6 modules; consider introducing a shared data module) (see https://github.com/takaishi/tflint-ruleset-takaishi#indirect-cycles)",
+				},
+				{
+					Rule:    NewModuleCircularDependencyRule(),
+					Message: "Circular dependency detected between modules: module_e ↔ module_f (path: module_a → module_b → module_c → module_d → module_e → module_f → module_a) (cycle spans 6 modules; consider introducing a shared data module) (see https://github.com/takaishi/tflint-ruleset-takaishi#indirect-cycles)",
+				},
+				{
+					Rule:    NewModuleCircularDependencyRule(),
+					Message: "Circular dependency detected between modules: module_f ↔ module_a (path: module_a → module_b → module_c → module_d → module_e → module_f → module_a) (cycle spans 6 modules; consider introducing a shared data module) (see https://github.com/takaishi/tflint-ruleset-takaishi#indirect-cycles)",
+				},
+			},
+		},
+		{
+			name: "short cycle has no refactor hint",
+			content: `
+module "module_a" {
+  source = "./modules/a"
+  input = module.module_b.output
+}
+
+module "module_b" {
+  source = "./modules/b"
+  input = module.module_c.output
+}
+
+module "module_c" {
+  source = "./modules/c"
+  input = module.module_a.output
+}`,
+			config: `
+rule "module_circular_dependency" {
+  enabled = true
+  long_cycle_threshold = 5
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewModuleCircularDependencyRule(),
+					Message: "Circular dependency detected between modules: module_a ↔ module_b (path: module_a → module_b → module_c → module_a) (see https://github.com/takaishi/tflint-ruleset-takaishi#indirect-cycles)",
+				},
+				{
+					Rule:    NewModuleCircularDependencyRule(),
+					Message: "Circular dependency detected between modules: module_b ↔ module_c (path: module_a → module_b → module_c → module_a) (see https://github.com/takaishi/tflint-ruleset-takaishi#indirect-cycles)",
+				},
+				{
+					Rule:    NewModuleCircularDependencyRule(),
+					Message: "Circular dependency detected between modules: module_c ↔ module_a (path: module_a → module_b → module_c → module_a) (see https://github.com/takaishi/tflint-ruleset-takaishi#indirect-cycles)",
+				},
+			},
+		},
+		{
+			name: "circular dependency through heredoc templates",
+			content: `
+module "module_a" {
+  source = "./modules/a"
+  input = <<EOT
+%{ for value in module.module_b.values ~}
+${value}
+%{ endfor ~}
+EOT
+}
+
+module "module_b" {
+  source = "./modules/b"
+  input = <<EOT
+${module.module_a.output}
+EOT
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewModuleCircularDependencyRule(),
+					Message: "Circular dependency detected between modules: module_a ↔ module_b (see https://github.com/takaishi/tflint-ruleset-takaishi#direct-cycles)",
+				},
+				{
+					Rule:    NewModuleCircularDependencyRule(),
+					Message: "Circular dependency detected between modules: module_b ↔ module_a (see https://github.com/takaishi/tflint-ruleset-takaishi#direct-cycles)",
+				},
+			},
+		},
+		{
+			name: "multiple distinct cycles are ordered by cycle",
+			content: `
+module "module_y" {
+  source = "./modules/y"
+  input = module.module_x.output
+}
+
+module "module_x" {
+  source = "./modules/x"
+  input = module.module_y.output
+}
+
+module "module_d" {
+  source = "./modules/d"
+  input = module.module_c.output
+}
+
+module "module_c" {
+  source = "./modules/c"
+  input = module.module_d.output
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewModuleCircularDependencyRule(),
+					Message: "Circular dependency detected between modules: module_c ↔ module_d (see https://github.com/takaishi/tflint-ruleset-takaishi#direct-cycles)",
+				},
+				{
+					Rule:    NewModuleCircularDependencyRule(),
+					Message: "Circular dependency detected between modules: module_d ↔ module_c (see https://github.com/takaishi/tflint-ruleset-takaishi#direct-cycles)",
+				},
+				{
+					Rule:    NewModuleCircularDependencyRule(),
+					Message: "Circular dependency detected between modules: module_x ↔ module_y (see https://github.com/takaishi/tflint-ruleset-takaishi#direct-cycles)",
+				},
+				{
+					Rule:    NewModuleCircularDependencyRule(),
+					Message: "Circular dependency detected between modules: module_y ↔ module_x (see https://github.com/takaishi/tflint-ruleset-takaishi#direct-cycles)",
+				},
+			},
+		},
+		{
+			name: "direct and indirect cycles are ordered by cycle",
+			content: `
+module "module_x" {
+  source = "./modules/x"
+  input = module.module_y.output
+}
+
+module "module_y" {
+  source = "./modules/y"
+  input = module.module_x.output
+}
+
+module "module_a" {
+  source = "./modules/a"
+  input = module.module_b.output
+}
+
+module "module_b" {
+  source = "./modules/b"
+  input = module.module_c.output
+}
+
+module "module_c" {
+  source = "./modules/c"
+  input = module.module_a.output
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewModuleCircularDependencyRule(),
+					Message: "Circular dependency detected between modules: module_a ↔ module_b (path: module_a → module_b → module_c → module_a) (see https://github.com/takaishi/tflint-ruleset-takaishi#indirect-cycles)",
+				},
+				{
+					Rule:    NewModuleCircularDependencyRule(),
+					Message: "Circular dependency detected between modules: module_b ↔ module_c (path: module_a → module_b → module_c → module_a) (see https://github.com/takaishi/tflint-ruleset-takaishi#indirect-cycles)",
+				},
+				{
+					Rule:    NewModuleCircularDependencyRule(),
+					Message: "Circular dependency detected between modules: module_c ↔ module_a (path: module_a → module_b → module_c → module_a) (see https://github.com/takaishi/tflint-ruleset-takaishi#indirect-cycles)",
+				},
+				{
+					Rule:    NewModuleCircularDependencyRule(),
+					Message: "Circular dependency detected between modules: module_x ↔ module_y (see https://github.com/takaishi/tflint-ruleset-takaishi#direct-cycles)",
+				},
+				{
+					Rule:    NewModuleCircularDependencyRule(),
+					Message: "Circular dependency detected between modules: module_y ↔ module_x (see https://github.com/takaishi/tflint-ruleset-takaishi#direct-cycles)",
+				},
+			},
+		},
+		{
+			name: "circular dependency through locals",
+			content: `
+locals {
+  b_output = module.module_b.output
+}
+
+module "module_a" {
+  source = "./modules/a"
+  input = local.b_output
+}
+
+module "module_b" {
+  source = "./modules/b"
+  input = module.module_a.output
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewModuleCircularDependencyRule(),
+					Message: "Circular dependency detected between modules: module_a ↔ module_b (see https://github.com/takaishi/tflint-ruleset-takaishi#direct-cycles)",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 8, Column: 3},
+						End:      hcl.Pos{Line: 8, Column: 25},
+					},
+				},
+				{
+					Rule:    NewModuleCircularDependencyRule(),
+					Message: "Circular dependency detected between modules: module_b ↔ module_a (see https://github.com/takaishi/tflint-ruleset-takaishi#direct-cycles)",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 13, Column: 3},
+						End:      hcl.Pos{Line: 13, Column: 33},
+					},
+				},
+			},
+		},
+		{
+			name: "circular dependency in conditional predicate",
+			content: `
+module "module_a" {
+  source = "./modules/a"
+  input = module.module_b.flag ? "a" : "b"
+}
+
+module "module_b" {
+  source = "./modules/b"
+  input = module.module_a.output
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewModuleCircularDependencyRule(),
+					Message: "Circular dependency detected between modules: module_a ↔ module_b (see https://github.com/takaishi/tflint-ruleset-takaishi#direct-cycles)",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 4, Column: 3},
+						End:      hcl.Pos{Line: 4, Column: 43},
+					},
+				},
+				{
+					Rule:    NewModuleCircularDependencyRule(),
+					Message: "Circular dependency detected between modules: module_b ↔ module_a (see https://github.com/takaishi/tflint-ruleset-takaishi#direct-cycles)",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 9, Column: 3},
+						End:      hcl.Pos{Line: 9, Column: 33},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewModuleCircularDependencyRule()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			files := map[string]string{"main.tf": test.content}
+			if test.config != "" {
+				files[".tflint.hcl"] = test.config
+			}
+			runner := helper.TestRunner(t, files)
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			// Check expected error count
+			if len(runner.Issues) != len(test.expected) {
+				t.Errorf("Expected %d issues, got %d", len(test.expected), len(runner.Issues))
+				for _, issue := range runner.Issues {
+					t.Logf("Issue: %s", issue.Message)
+				}
+				return
+			}
+
+			// Check if circular dependency error message is included
+			for i, expectedIssue := range test.expected {
+				if i >= len(runner.Issues) {
+					break
+				}
+				actualIssue := runner.Issues[i]
+
+				// Check if message contains "Circular dependency detected between modules"
+				if expectedIssue.Message != "" {
+					// Allow either module_a ↔ module_b or module_b ↔ module_a
+					containsCircular := false
+					if actualIssue.Message == expectedIssue.Message {
+						containsCircular = true
+					} else {
+						// Allow reverse order message
+						reverseMsg := "Circular dependency detected between modules: module_b ↔ module_a (see https://github.com/takaishi/tflint-ruleset-takaishi#direct-cycles)"
+						if expectedIssue.Message == "Circular dependency detected between modules: module_a ↔ module_b (see https://github.com/takaishi/tflint-ruleset-takaishi#direct-cycles)" && actualIssue.Message == reverseMsg {
+							containsCircular = true
+						}
+					}
+
+					if !containsCircular {
+						t.Errorf("Expected message '%s', got '%s'", expectedIssue.Message, actualIssue.Message)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestModuleCircularDependencyRuleDirectCycleRanges(t *testing.T) {
+	content := `
+module "module_a" {
+  source = "./modules/a"
+  input = module.module_b.output
+}
+
+module "module_b" {
+  source = "./modules/b"
+  other = "value"
+  input = module.module_a.output
+}`
+
+	rule := NewModuleCircularDependencyRule()
+	runner := helper.TestRunner(t, map[string]string{"main.tf": content})
+	if err := rule.Check(runner); err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+
+	if len(runner.Issues) != 2 {
+		t.Fatalf("Expected 2 issues, got %d", len(runner.Issues))
+	}
+
+	// The first issue points at module_a → module_b, the second at module_b → module_a
+	if line := runner.Issues[0].Range.Start.Line; line != 4 {
+		t.Errorf("Expected primary range to start at line 4, got %d", line)
+	}
+	if line := runner.Issues[1].Range.Start.Line; line != 10 {
+		t.Errorf("Expected secondary range to start at line 10, got %d", line)
+	}
+
+	// Only the primary issue fails the run; the reverse edge is informational
+	if severity := runner.Issues[0].Rule.Severity(); severity != tflint.ERROR {
+		t.Errorf("Expected primary issue severity %s, got %s", tflint.ERROR, severity)
+	}
+	if severity := runner.Issues[1].Rule.Severity(); severity != tflint.NOTICE {
+		t.Errorf("Expected secondary issue severity %s, got %s", tflint.NOTICE, severity)
+	}
+}
+
+func TestModuleCircularDependencyRuleJSON(t *testing.T) {
+	content := `{
+  "module": {
+    "module_a": {
+      "source": "./modules/a",
+      "input": "${module.module_b.output}"
+    },
+    "module_b": {
+      "source": "./modules/b",
+      "input": "${module.module_a.output}"
+    }
+  }
+}`
+
+	rule := NewModuleCircularDependencyRule()
+	runner := helper.TestRunner(t, map[string]string{"main.tf.json": content})
+	if err := rule.Check(runner); err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+
+	helper.AssertIssuesWithoutRange(t, helper.Issues{
+		{
+			Rule:    rule,
+			Message: "Circular dependency detected between modules: module_a ↔ module_b (see https://github.com/takaishi/tflint-ruleset-takaishi#direct-cycles)",
+		},
+		{
+			Rule:    noticeRule{rule},
+			Message: "Circular dependency detected between modules: module_b ↔ module_a (see https://github.com/takaishi/tflint-ruleset-takaishi#direct-cycles)",
+		},
+	}, runner.Issues)
+}
+
+func TestModuleCircularDependencyRuleReportFile(t *testing.T) {
+	reportFile := filepath.Join(t.TempDir(), "report.json")
+
+	content := `
+module "module_a" {
+  source = "./modules/a"
+  input = module.module_b.output
+}
+
+module "module_b" {
+  source = "./modules/b"
+  input = module.module_c.output
+}
+
+module "module_c" {
+  source = "./modules/c"
+  input = module.module_a.output
+}`
+	config := fmt.Sprintf(`
+rule "module_circular_dependency" {
+  enabled = true
+  report_file = %q
+}`, reportFile)
+
+	rule := NewModuleCircularDependencyRule()
+	runner := helper.TestRunner(t, map[string]string{"main.tf": content, ".tflint.hcl": config})
+	if err := rule.Check(runner); err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+
+	// Issues are still emitted alongside the report
+	if len(runner.Issues) != 3 {
+		t.Fatalf("Expected 3 issues, got %d", len(runner.Issues))
+	}
+
+	data, err := os.ReadFile(reportFile)
+	if err != nil {
+		t.Fatalf("Failed to read report file: %s", err)
+	}
+
+	var report cycleReport
+	if err := json.Unmarshal(data, &report); err != nil {
+		t.Fatalf("Failed to parse report file: %s", err)
+	}
+
+	expected := []cycleReportEntry{
+		{ModuleA: "module_a", ModuleB: "module_b", CyclePath: "module_a → module_b → module_c → module_a", Filename: "main.tf", Line: 4},
+		{ModuleA: "module_b", ModuleB: "module_c", CyclePath: "module_a → module_b → module_c → module_a", Filename: "main.tf", Line: 9},
+		{ModuleA: "module_c", ModuleB: "module_a", CyclePath: "module_a → module_b → module_c → module_a", Filename: "main.tf", Line: 14},
+	}
+	if len(report.Cycles) != len(expected) {
+		t.Fatalf("Expected %d report entries, got %d", len(expected), len(report.Cycles))
+	}
+	for i, entry := range expected {
+		if report.Cycles[i] != entry {
+			t.Errorf("Expected report entry %+v, got %+v", entry, report.Cycles[i])
+		}
+	}
+}
+
+// benchmarkRunner is a minimal runner that only serves pre-parsed files
+type benchmarkRunner struct {
+	tflint.Runner
+	files map[string]*hcl.File
+}
+
+func (r *benchmarkRunner) GetFiles() (map[string]*hcl.File, error) {
+	return r.files, nil
+}
+
+// newBenchmarkRunner generates a runner with one module per file chained to the previous one
+func newBenchmarkRunner(b *testing.B, count int) *benchmarkRunner {
+	b.Helper()
+
+	parser := hclparse.NewParser()
+	files := make(map[string]*hcl.File)
+	for i := 0; i < count; i++ {
+		content := fmt.Sprintf("module \"module_%d\" {\n  source = \"./modules/m\"\n  input = module.module_%d.output\n}\n", i, (i+1)%count)
+		name := fmt.Sprintf("module_%d.tf", i)
+		file, diags := parser.ParseHCL([]byte(content), name)
+		if diags.HasErrors() {
+			b.Fatal(diags)
+		}
+		files[name] = file
+	}
+
+	return &benchmarkRunner{files: files}
+}
+
+// BenchmarkModuleCircularDependencyRuleSharedFiles fetches the files once and shares them
+// between the collect and build phases, each of which walks the files separately
+func BenchmarkModuleCircularDependencyRuleSharedFiles(b *testing.B) {
+	runner := newBenchmarkRunner(b, 500)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		files, fileNames, err := sortedFiles(runner)
+		if err != nil {
+			b.Fatal(err)
+		}
+		collected, err := parseModuleGraph(files, fileNames)
+		if err != nil {
+			b.Fatal(err)
+		}
+		modules := collected.modules()
+		built, err := parseModuleGraph(files, fileNames)
+		if err != nil {
+			b.Fatal(err)
+		}
+		built.dependencies(modules)
+	}
+}
+
+// BenchmarkModuleCircularDependencyRulePerPhaseFiles fetches and sorts the files again for each phase
+func BenchmarkModuleCircularDependencyRulePerPhaseFiles(b *testing.B) {
+	runner := newBenchmarkRunner(b, 500)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		files, fileNames, err := sortedFiles(runner)
+		if err != nil {
+			b.Fatal(err)
+		}
+		collected, err := parseModuleGraph(files, fileNames)
+		if err != nil {
+			b.Fatal(err)
+		}
+		modules := collected.modules()
+		files, fileNames, err = sortedFiles(runner)
+		if err != nil {
+			b.Fatal(err)
+		}
+		built, err := parseModuleGraph(files, fileNames)
+		if err != nil {
+			b.Fatal(err)
+		}
+		built.dependencies(modules)
+	}
+}
+
+// BenchmarkModuleCircularDependencyRuleSinglePass walks the files once and resolves dependencies
+// from the extracted blocks; compare it with the two-pass BenchmarkModuleCircularDependencyRuleSharedFiles
+func BenchmarkModuleCircularDependencyRuleSinglePass(b *testing.B) {
+	runner := newBenchmarkRunner(b, 500)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		files, fileNames, err := sortedFiles(runner)
+		if err != nil {
+			b.Fatal(err)
+		}
+		graph, err := parseModuleGraph(files, fileNames)
+		if err != nil {
+			b.Fatal(err)
+		}
+		graph.dependencies(graph.modules())
+	}
+}
+
+func TestModuleCircularDependencyRuleFindCycleLongChain(t *testing.T) {
+	const count = 5000
+
+	// Build a chain module_0 → module_1 → ... → module_4999 → module_0
+	depMap := make(map[string][]string)
+	for i := 0; i < count; i++ {
+		depMap[fmt.Sprintf("module_%d", i)] = []string{fmt.Sprintf("module_%d", (i+1)%count)}
+	}
+
+	rule := NewModuleCircularDependencyRule()
+	path := []string{}
+	cycle := rule.findCycle("module_0", depMap, make(map[string]bool), make(map[string]bool), &path)
+
+	if len(cycle) != count {
+		t.Fatalf("Expected a cycle of %d modules, got %d", count, len(cycle))
+	}
+	if cycle[0] != "module_0" || cycle[count-1] != fmt.Sprintf("module_%d", count-1) {
+		t.Errorf("Expected cycle to start at module_0 and end at module_%d, got %s ... %s", count-1, cycle[0], cycle[count-1])
+	}
+
+	// Without the back edge the chain has no cycle
+	depMap[fmt.Sprintf("module_%d", count-1)] = nil
+	path = []string{}
+	if cycle := rule.findCycle("module_0", depMap, make(map[string]bool), make(map[string]bool), &path); cycle != nil {
+		t.Errorf("Expected no cycle, got %d modules", len(cycle))
+	}
+	if len(path) != 0 {
+		t.Errorf("Expected path to be empty after search, got %d modules", len(path))
+	}
+}
+
+func TestModuleCircularDependencyRuleFindCycleEntryPoint(t *testing.T) {
+	// module_a leads into the cycle module_b → module_c → module_b
+	depMap := map[string][]string{
+		"module_a": {"module_b"},
+		"module_b": {"module_c"},
+		"module_c": {"module_b"},
+	}
+
+	rule := NewModuleCircularDependencyRule()
+	path := []string{}
+	cycle := rule.findCycle("module_a", depMap, make(map[string]bool), make(map[string]bool), &path)
+
+	expected := []string{"module_b", "module_c"}
+	if fmt.Sprint(cycle) != fmt.Sprint(expected) {
+		t.Errorf("Expected cycle %v, got %v", expected, cycle)
+	}
+}
+
+func TestModuleCircularDependencyRuleSeverity(t *testing.T) {
+	content := `
+module "module_a" {
+  source = "./modules/a"
+  input = module.module_b.output
+}
+
+module "module_b" {
+  source = "./modules/b"
+  input = module.module_a.output
+}`
+
+	tests := []struct {
+		name     string
+		config   string
+		expected tflint.Severity
+	}{
+		{
+			name:     "default severity",
+			expected: tflint.ERROR,
+		},
+		{
+			name: "warning severity",
+			config: `
+rule "module_circular_dependency" {
+  enabled = true
+  severity = "warning"
+}`,
+			expected: tflint.WARNING,
+		},
+		{
+			name: "notice severity",
+			config: `
+rule "module_circular_dependency" {
+  enabled = true
+  severity = "notice"
+}`,
+			expected: tflint.NOTICE,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			files := map[string]string{"main.tf": content}
+			if test.config != "" {
+				files[".tflint.hcl"] = test.config
+			}
+
+			rule := NewModuleCircularDependencyRule()
+			runner := helper.TestRunner(t, files)
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			if len(runner.Issues) == 0 {
+				t.Fatal("Expected issues, got none")
+			}
+			for _, issue := range runner.Issues {
+				// The reverse edge of a direct cycle is informational and always reported at NOTICE
+				if _, ok := issue.Rule.(noticeRule); ok {
+					continue
+				}
+				if issue.Rule.Severity() != test.expected {
+					t.Errorf("Expected severity %s, got %s", test.expected, issue.Rule.Severity())
+				}
+			}
+		})
+	}
+}
+
+func TestModuleCircularDependencyRuleInvalidSeverity(t *testing.T) {
+	runner := helper.TestRunner(t, map[string]string{
+		"main.tf": `
+module "module_a" {
+  source = "./modules/a"
+}`,
+		".tflint.hcl": `
+rule "module_circular_dependency" {
+  enabled = true
+  severity = "critical"
+}`,
+	})
+
+	if err := NewModuleCircularDependencyRule().Check(runner); err == nil {
+		t.Fatal("Expected an error for an invalid severity, got nil")
+	}
+}
+
+func TestModuleCircularDependencyRuleExcludePaths(t *testing.T) {
+	runner := helper.TestRunner(t, map[string]string{
+		"main.tf": `
+module "module_a" {
+  source = "./modules/a"
+}`,
+		"vendor/main.tf": `
+module "module_b" {
+  source = "./modules/b"
+  input = module.module_c.output
+}
+
+module "module_c" {
+  source = "./modules/c"
+  input = module.module_b.output
+}`,
+		".tflint.hcl": `
+rule "module_circular_dependency" {
+  enabled = true
+  exclude_paths = ["vendor/*"]
+}`,
+	})
+
+	if err := NewModuleCircularDependencyRule().Check(runner); err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+
+	helper.AssertIssues(t, helper.Issues{}, runner.Issues)
+}
+
+func TestModuleCircularDependencyRuleReportDepth(t *testing.T) {
+	runner := helper.TestRunner(t, map[string]string{
+		"main.tf": `
+module "module_a" {
+  source = "./modules/a"
+  input = module.module_b.output
+}
+
+module "module_b" {
+  source = "./modules/b"
+  input = module.module_c.output
+}
+
+module "module_c" {
+  source = "./modules/c"
+}`,
+		".tflint.hcl": `
+rule "module_circular_dependency" {
+  enabled = true
+  report_depth = true
+}`,
+	})
+
+	rule := NewModuleCircularDependencyRule()
+	if err := rule.Check(runner); err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+
+	helper.AssertIssues(t, helper.Issues{
+		{
+			Rule:    noticeRule{rule},
+			Message: "Module \"module_a\" has a dependency depth of 2",
+			Range: hcl.Range{
+				Filename: "main.tf",
+				Start:    hcl.Pos{Line: 2, Column: 1},
+				End:      hcl.Pos{Line: 2, Column: 18},
+			},
+		},
+	}, runner.Issues)
+
+	if severity := runner.Issues[0].Rule.Severity(); severity != tflint.NOTICE {
+		t.Errorf("Expected depth issue severity %s, got %s", tflint.NOTICE, severity)
+	}
+}
+
+func TestModuleCircularDependencyRuleNamePrefix(t *testing.T) {
+	runner := helper.TestRunner(t, map[string]string{
+		"main.tf": `
+module "team_a" {
+  source = "./modules/a"
+  input = module.team_b.output
+}
+
+module "team_b" {
+  source = "./modules/b"
+  input = module.team_a.output
+}
+
+module "other_a" {
+  source = "./modules/other_a"
+  input = module.other_b.output
+}
+
+module "other_b" {
+  source = "./modules/other_b"
+  input = module.other_a.output
+}
+
+module "team_c" {
+  source = "./modules/c"
+  input = module.other_c.output
+}
+
+module "other_c" {
+  source = "./modules/other_c"
+  input = module.team_c.output
+}`,
+		".tflint.hcl": `
+rule "module_circular_dependency" {
+  enabled = true
+  name_prefix = "team_"
+}`,
+	})
+
+	rule := NewModuleCircularDependencyRule()
+	if err := rule.Check(runner); err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+
+	helper.AssertIssuesWithoutRange(t, helper.Issues{
+		{
+			Rule:    rule,
+			Message: "Circular dependency detected between modules: team_a ↔ team_b (see https://github.com/takaishi/tflint-ruleset-takaishi#direct-cycles)",
+		},
+		{
+			Rule:    noticeRule{rule},
+			Message: "Circular dependency detected between modules: team_b ↔ team_a (see https://github.com/takaishi/tflint-ruleset-takaishi#direct-cycles)",
+		},
+	}, runner.Issues)
+}
+
+func TestModuleCircularDependencyRuleCycleTypeAnchors(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		anchor  string
+	}{
+		{
+			name: "direct cycle",
+			content: `
+module "module_a" {
+  source = "./modules/a"
+  input = module.module_b.output
+}
+
+module "module_b" {
+  source = "./modules/b"
+  input = module.module_a.output
+}`,
+			anchor: "#direct-cycles",
+		},
+		{
+			name: "indirect cycle",
+			content: `
+module "module_a" {
+  source = "./modules/a"
+  input = module.module_b.output
+}
+
+module "module_b" {
+  source = "./modules/b"
+  input = module.module_c.output
+}
+
+module "module_c" {
+  source = "./modules/c"
+  input = module.module_a.output
+}`,
+			anchor: "#indirect-cycles",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": test.content})
+
+			rule := NewModuleCircularDependencyRule()
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			if len(runner.Issues) == 0 {
+				t.Fatal("Expected issues, got none")
+			}
+			for _, issue := range runner.Issues {
+				if !strings.Contains(issue.Message, test.anchor) {
+					t.Errorf("Expected message to contain %q, got %q", test.anchor, issue.Message)
+				}
+			}
+		})
+	}
+}
+
+func TestModuleCircularDependencyRuleFollowNested(t *testing.T) {
+	dir := t.TempDir()
+	fixtures := map[string]string{
+		"parent": `
+module "middle" {
+  source = "../middle"
+}`,
+		"middle": `
+module "child_a" {
+  source = "../child_a"
+  input = module.child_b.output
+}
+
+module "child_b" {
+  source = "../child_b"
+  input = module.child_a.output
+}`,
+	}
+	for name, content := range fixtures {
+		if err := os.MkdirAll(filepath.Join(dir, "modules", name), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, "modules", name, "main.tf"), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	mainFile := filepath.Join(dir, "main.tf")
+	middleFile := filepath.Join(dir, "modules", "middle", "main.tf")
+	content := `
+module "parent" {
+  source = "./modules/parent"
+}
+
+module "other" {
+  source = "./modules/parent"
+}`
+
+	tests := []struct {
+		name     string
+		config   string
+		expected helper.Issues
+	}{
+		{
+			name: "nested modules are not followed by default",
+			config: `
+rule "module_circular_dependency" {
+  enabled = true
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "two-level nested cycle",
+			config: `
+rule "module_circular_dependency" {
+  enabled = true
+  follow_nested = true
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewModuleCircularDependencyRule(),
+					Message: "Circular dependency detected between modules: parent.middle.child_a ↔ parent.middle.child_b (see https://github.com/takaishi/tflint-ruleset-takaishi#direct-cycles)",
+					Range: hcl.Range{
+						Filename: middleFile,
+						Start:    hcl.Pos{Line: 4, Column: 3},
+						End:      hcl.Pos{Line: 4, Column: 32},
+					},
+				},
+				{
+					Rule:    noticeRule{NewModuleCircularDependencyRule()},
+					Message: "Circular dependency detected between modules: parent.middle.child_b ↔ parent.middle.child_a (see https://github.com/takaishi/tflint-ruleset-takaishi#direct-cycles)",
+					Range: hcl.Range{
+						Filename: middleFile,
+						Start:    hcl.Pos{Line: 9, Column: 3},
+						End:      hcl.Pos{Line: 9, Column: 32},
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{
+				mainFile:      content,
+				".tflint.hcl": test.config,
+			})
+
+			rule := NewModuleCircularDependencyRule()
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.expected, runner.Issues)
+		})
+	}
+}
+
+func TestModuleCircularDependencyRuleIgnoreDisabled(t *testing.T) {
+	content := `
+module "module_a" {
+  source = "./modules/a"
+  input = module.module_b.output
+}
+
+module "module_b" {
+  source = "./modules/b"
+  count = 0
+  input = module.module_a.output
+}
+
+module "module_c" {
+  source = "./modules/c"
+  input = module.module_d.output
+}
+
+module "module_d" {
+  source = "./modules/d"
+  for_each = {}
+  input = module.module_c.output
+}
+
+module "module_e" {
+  source = "./modules/e"
+  count = 1
+  input = module.module_f.output
+}
+
+module "module_f" {
+  source = "./modules/f"
+  input = module.module_e.output
+}`
+
+	tests := []struct {
+		name     string
+		config   string
+		expected helper.Issues
+	}{
+		{
+			name: "disabled modules are considered by default",
+			config: `
+rule "module_circular_dependency" {
+  enabled = true
+  collapse_cycles = true
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewModuleCircularDependencyRule(),
+					Message: "Circular dependency: module_a → module_b → module_a (see https://github.com/takaishi/tflint-ruleset-takaishi#direct-cycles)",
+				},
+				{
+					Rule:    NewModuleCircularDependencyRule(),
+					Message: "Circular dependency: module_c → module_d → module_c (see https://github.com/takaishi/tflint-ruleset-takaishi#direct-cycles)",
+				},
+				{
+					Rule:    NewModuleCircularDependencyRule(),
+					Message: "Circular dependency: module_e → module_f → module_e (see https://github.com/takaishi/tflint-ruleset-takaishi#direct-cycles)",
+				},
+			},
+		},
+		{
+			name: "ignore disabled modules",
+			config: `
+rule "module_circular_dependency" {
+  enabled = true
+  collapse_cycles = true
+  ignore_disabled = true
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewModuleCircularDependencyRule(),
+					Message: "Circular dependency: module_e → module_f → module_e (see https://github.com/takaishi/tflint-ruleset-takaishi#direct-cycles)",
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{
+				"main.tf":     content,
+				".tflint.hcl": test.config,
+			})
+
+			rule := NewModuleCircularDependencyRule()
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssuesWithoutRange(t, test.expected, runner.Issues)
 		})
 	}
 }
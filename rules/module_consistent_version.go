@@ -0,0 +1,133 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// ModuleConsistentVersionRule checks that modules sharing a source pin the same version
+type ModuleConsistentVersionRule struct {
+	tflint.DefaultRule
+	docsLink
+}
+
+// moduleConsistentVersionRuleConfig is the configuration for the rule
+type moduleConsistentVersionRuleConfig struct {
+	AllowDivergence []string `hclext:"allow_divergence,optional"`
+}
+
+// NewModuleConsistentVersionRule creates a new rule instance
+func NewModuleConsistentVersionRule() *ModuleConsistentVersionRule {
+	return &ModuleConsistentVersionRule{}
+}
+
+// Name returns the rule name
+func (r *ModuleConsistentVersionRule) Name() string {
+	return "module_consistent_version"
+}
+
+// Enabled returns whether the rule is enabled
+func (r *ModuleConsistentVersionRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *ModuleConsistentVersionRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns a link to detailed information about the rule
+func (r *ModuleConsistentVersionRule) Link() string {
+	return r.link(r.Name())
+}
+
+// Check executes the rule checking process
+func (r *ModuleConsistentVersionRule) Check(runner tflint.Runner) error {
+	// Decode rule configuration
+	config := moduleConsistentVersionRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	allowed := make(map[string]bool)
+	for _, source := range config.AllowDivergence {
+		allowed[source] = true
+	}
+
+	files, fileNames, err := sortedFiles(runner)
+	if err != nil {
+		return err
+	}
+
+	// Group versions by source in order of appearance
+	var sources []string
+	versions := make(map[string][]string)
+	seen := make(map[string]map[string]bool)
+	divergedAt := make(map[string]hcl.Range) // Range of the first module pinning a different version
+
+	for _, fileName := range fileNames {
+		body, ok := files[fileName].Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		for _, block := range body.Blocks {
+			if block.Type != "module" || len(block.Labels) == 0 {
+				continue
+			}
+
+			sourceAttr, exists := block.Body.Attributes["source"]
+			if !exists {
+				continue
+			}
+			versionAttr, exists := block.Body.Attributes["version"]
+			if !exists {
+				continue
+			}
+			source, ok := literalString(sourceAttr.Expr)
+			if !ok || allowed[source] {
+				continue
+			}
+			version, ok := literalString(versionAttr.Expr)
+			if !ok {
+				continue
+			}
+
+			if seen[source] == nil {
+				seen[source] = make(map[string]bool)
+				sources = append(sources, source)
+			}
+			if seen[source][version] {
+				continue
+			}
+			seen[source][version] = true
+			versions[source] = append(versions[source], version)
+			if len(versions[source]) == 2 {
+				divergedAt[source] = versionAttr.Range()
+			}
+		}
+	}
+
+	for _, source := range sources {
+		pinned := versions[source]
+		if len(pinned) < 2 {
+			continue
+		}
+
+		list := strings.Join(pinned[:len(pinned)-1], ", ") + " and " + pinned[len(pinned)-1]
+		err := runner.EmitIssue(
+			r,
+			fmt.Sprintf("Module source \"%s\" is pinned to both %s", source, list),
+			divergedAt[source],
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestModuleConsistentVersionRule(t *testing.T) {
+	divergent := `
+module "vpc_a" {
+  source  = "terraform-aws-modules/vpc/aws"
+  version = "1.0.0"
+}
+
+module "vpc_b" {
+  source  = "terraform-aws-modules/vpc/aws"
+  version = "2.0.0"
+}`
+
+	tests := []struct {
+		name     string
+		content  string
+		config   string
+		expected helper.Issues
+	}{
+		{
+			name: "consistent versions",
+			content: `
+module "vpc_a" {
+  source  = "terraform-aws-modules/vpc/aws"
+  version = "1.0.0"
+}
+
+module "vpc_b" {
+  source  = "terraform-aws-modules/vpc/aws"
+  version = "1.0.0"
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name:    "divergent versions",
+			content: divergent,
+			expected: helper.Issues{
+				{
+					Rule:    NewModuleConsistentVersionRule(),
+					Message: "Module source \"terraform-aws-modules/vpc/aws\" is pinned to both 1.0.0 and 2.0.0",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 9, Column: 3},
+						End:      hcl.Pos{Line: 9, Column: 20},
+					},
+				},
+			},
+		},
+		{
+			name:    "whitelisted source",
+			content: divergent,
+			config: `
+rule "module_consistent_version" {
+  enabled = true
+  allow_divergence = ["terraform-aws-modules/vpc/aws"]
+}`,
+			expected: helper.Issues{},
+		},
+	}
+
+	rule := NewModuleConsistentVersionRule()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			files := map[string]string{"main.tf": test.content}
+			if test.config != "" {
+				files[".tflint.hcl"] = test.config
+			}
+			runner := helper.TestRunner(t, files)
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.expected, runner.Issues)
+		})
+	}
+}
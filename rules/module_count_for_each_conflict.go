@@ -0,0 +1,77 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// ModuleCountForEachConflictRule checks that a module does not set both count and for_each
+type ModuleCountForEachConflictRule struct {
+	tflint.DefaultRule
+	docsLink
+}
+
+// NewModuleCountForEachConflictRule creates a new rule instance
+func NewModuleCountForEachConflictRule() *ModuleCountForEachConflictRule {
+	return &ModuleCountForEachConflictRule{}
+}
+
+// Name returns the rule name
+func (r *ModuleCountForEachConflictRule) Name() string {
+	return "module_count_for_each_conflict"
+}
+
+// Enabled returns whether the rule is enabled
+func (r *ModuleCountForEachConflictRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *ModuleCountForEachConflictRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns a link to detailed information about the rule
+func (r *ModuleCountForEachConflictRule) Link() string {
+	return r.link(r.Name())
+}
+
+// Check executes the rule checking process
+func (r *ModuleCountForEachConflictRule) Check(runner tflint.Runner) error {
+	files, fileNames, err := sortedFiles(runner)
+	if err != nil {
+		return err
+	}
+
+	for _, fileName := range fileNames {
+		body, ok := files[fileName].Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		for _, block := range body.Blocks {
+			if block.Type != "module" || len(block.Labels) == 0 {
+				continue
+			}
+
+			_, hasCount := block.Body.Attributes["count"]
+			forEachAttr, hasForEach := block.Body.Attributes["for_each"]
+			if !hasCount || !hasForEach {
+				continue
+			}
+
+			err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("Module \"%s\" cannot use both count and for_each", block.Labels[0]),
+				forEachAttr.Range(),
+			)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
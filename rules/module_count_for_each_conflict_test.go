@@ -0,0 +1,76 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestModuleCountForEachConflictRule(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected helper.Issues
+	}{
+		{
+			name: "count only",
+			content: `
+module "module_a" {
+  source = "./modules/a"
+  count  = 2
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "for_each only",
+			content: `
+module "module_a" {
+  source   = "./modules/a"
+  for_each = toset(["a", "b"])
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "both count and for_each",
+			content: `
+module "module_a" {
+  source   = "./modules/a"
+  count    = 2
+  for_each = toset(["a", "b"])
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewModuleCountForEachConflictRule(),
+					Message: "Module \"module_a\" cannot use both count and for_each",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 5, Column: 3},
+						End:      hcl.Pos{Line: 5, Column: 31},
+					},
+				},
+			},
+		},
+		{
+			name: "neither",
+			content: `
+module "module_a" {
+  source = "./modules/a"
+}`,
+			expected: helper.Issues{},
+		},
+	}
+
+	rule := NewModuleCountForEachConflictRule()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": test.content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.expected, runner.Issues)
+		})
+	}
+}
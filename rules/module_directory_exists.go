@@ -0,0 +1,115 @@
+package rules
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// ModuleDirectoryExistsRule checks that local module sources point to a directory with Terraform files
+type ModuleDirectoryExistsRule struct {
+	tflint.DefaultRule
+	docsLink
+}
+
+// NewModuleDirectoryExistsRule creates a new rule instance
+func NewModuleDirectoryExistsRule() *ModuleDirectoryExistsRule {
+	return &ModuleDirectoryExistsRule{}
+}
+
+// Name returns the rule name
+func (r *ModuleDirectoryExistsRule) Name() string {
+	return "module_directory_exists"
+}
+
+// Enabled returns whether the rule is enabled
+func (r *ModuleDirectoryExistsRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *ModuleDirectoryExistsRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns a link to detailed information about the rule
+func (r *ModuleDirectoryExistsRule) Link() string {
+	return r.link(r.Name())
+}
+
+// Check executes the rule checking process
+func (r *ModuleDirectoryExistsRule) Check(runner tflint.Runner) error {
+	files, fileNames, err := sortedFiles(runner)
+	if err != nil {
+		return err
+	}
+
+	for _, fileName := range fileNames {
+		body, ok := files[fileName].Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		for _, block := range body.Blocks {
+			if block.Type != "module" || len(block.Labels) == 0 {
+				continue
+			}
+
+			sourceAttr, exists := block.Body.Attributes["source"]
+			if !exists {
+				continue
+			}
+			source, ok := literalString(sourceAttr.Expr)
+			if !ok || !isLocalSource(source) {
+				continue
+			}
+
+			// Resolve the source relative to the directory of the defining file
+			dir := filepath.Join(filepath.Dir(sourceAttr.Range().Filename), source)
+			found, err := hasTerraformFiles(dir)
+			if err != nil {
+				return err
+			}
+			if found {
+				continue
+			}
+
+			err = runner.EmitIssue(
+				r,
+				fmt.Sprintf("Module \"%s\" source directory \"%s\" does not exist", block.Labels[0], source),
+				sourceAttr.Range(),
+			)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// hasTerraformFiles returns whether a directory exists and contains at least one .tf or .tf.json file
+func hasTerraformFiles(dir string) (bool, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) || os.IsPermission(err) {
+			return false, nil
+		}
+		// A path that is not a directory has no Terraform files
+		if info, statErr := os.Stat(dir); statErr == nil && !info.IsDir() {
+			return false, nil
+		}
+		return false, err
+	}
+
+	for _, entry := range entries {
+		if !entry.IsDir() && isTerraformFile(entry.Name()) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
@@ -0,0 +1,112 @@
+package rules
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestModuleDirectoryExistsRule(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "modules", "a"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "modules", "a", "main.tf"), []byte(""), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Join(dir, "modules", "empty"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Join(dir, "modules", "json"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "modules", "json", "main.tf.json"), []byte("{}"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	mainFile := filepath.Join(dir, "main.tf")
+
+	tests := []struct {
+		name     string
+		content  string
+		expected helper.Issues
+	}{
+		{
+			name: "existing module directory",
+			content: `
+module "module_a" {
+  source = "./modules/a"
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "module directory with only tf.json files",
+			content: `
+module "module_json" {
+  source = "./modules/json"
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "missing module directory",
+			content: `
+module "module_b" {
+  source = "./modules/b"
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewModuleDirectoryExistsRule(),
+					Message: "Module \"module_b\" source directory \"./modules/b\" does not exist",
+					Range: hcl.Range{
+						Filename: mainFile,
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 25},
+					},
+				},
+			},
+		},
+		{
+			name: "module directory without tf files",
+			content: `
+module "empty" {
+  source = "./modules/empty"
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewModuleDirectoryExistsRule(),
+					Message: "Module \"empty\" source directory \"./modules/empty\" does not exist",
+					Range: hcl.Range{
+						Filename: mainFile,
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 29},
+					},
+				},
+			},
+		},
+		{
+			name: "registry module is skipped",
+			content: `
+module "vpc" {
+  source  = "terraform-aws-modules/vpc/aws"
+  version = "5.1.0"
+}`,
+			expected: helper.Issues{},
+		},
+	}
+
+	rule := NewModuleDirectoryExistsRule()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{mainFile: test.content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// ModuleDuplicateSourceRule checks for multiple modules instantiated from the same local source
+type ModuleDuplicateSourceRule struct {
+	tflint.DefaultRule
+	docsLink
+}
+
+// NewModuleDuplicateSourceRule creates a new rule instance
+func NewModuleDuplicateSourceRule() *ModuleDuplicateSourceRule {
+	return &ModuleDuplicateSourceRule{}
+}
+
+// Name returns the rule name
+func (r *ModuleDuplicateSourceRule) Name() string {
+	return "module_duplicate_source"
+}
+
+// Enabled returns whether the rule is enabled
+func (r *ModuleDuplicateSourceRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *ModuleDuplicateSourceRule) Severity() tflint.Severity {
+	return tflint.NOTICE
+}
+
+// Link returns a link to detailed information about the rule
+func (r *ModuleDuplicateSourceRule) Link() string {
+	return r.link(r.Name())
+}
+
+// Check executes the rule checking process
+func (r *ModuleDuplicateSourceRule) Check(runner tflint.Runner) error {
+	files, fileNames, err := sortedFiles(runner)
+	if err != nil {
+		return err
+	}
+
+	// First module seen for each local source
+	firstModules := make(map[string]string)
+
+	for _, fileName := range fileNames {
+		body, ok := files[fileName].Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		for _, block := range body.Blocks {
+			if block.Type != "module" || len(block.Labels) == 0 {
+				continue
+			}
+			moduleName := block.Labels[0]
+
+			sourceAttr, exists := block.Body.Attributes["source"]
+			if !exists {
+				continue
+			}
+			source, ok := literalString(sourceAttr.Expr)
+			if !ok || !isLocalSource(source) {
+				continue
+			}
+
+			first, exists := firstModules[source]
+			if !exists {
+				firstModules[source] = moduleName
+				continue
+			}
+
+			err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("Modules \"%s\" and \"%s\" share source \"%s\"; consider for_each", first, moduleName, source),
+				sourceAttr.Range(),
+			)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
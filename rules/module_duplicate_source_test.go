@@ -0,0 +1,78 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestModuleDuplicateSourceRule(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected helper.Issues
+	}{
+		{
+			name: "single-use sources",
+			content: `
+module "module_a" {
+  source = "./modules/a"
+}
+
+module "module_b" {
+  source = "./modules/b"
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "duplicate local source",
+			content: `
+module "module_a" {
+  source = "./modules/x"
+}
+
+module "module_b" {
+  source = "./modules/x"
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewModuleDuplicateSourceRule(),
+					Message: "Modules \"module_a\" and \"module_b\" share source \"./modules/x\"; consider for_each",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 7, Column: 3},
+						End:      hcl.Pos{Line: 7, Column: 25},
+					},
+				},
+			},
+		},
+		{
+			name: "duplicate registry source",
+			content: `
+module "vpc_a" {
+  source  = "terraform-aws-modules/vpc/aws"
+  version = "5.1.0"
+}
+
+module "vpc_b" {
+  source  = "terraform-aws-modules/vpc/aws"
+  version = "5.1.0"
+}`,
+			expected: helper.Issues{},
+		},
+	}
+
+	rule := NewModuleDuplicateSourceRule()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": test.content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// ModuleForEachMapRule checks that module for_each is not given a list literal
+type ModuleForEachMapRule struct {
+	tflint.DefaultRule
+	docsLink
+}
+
+// NewModuleForEachMapRule creates a new rule instance
+func NewModuleForEachMapRule() *ModuleForEachMapRule {
+	return &ModuleForEachMapRule{}
+}
+
+// Name returns the rule name
+func (r *ModuleForEachMapRule) Name() string {
+	return "module_for_each_map"
+}
+
+// Enabled returns whether the rule is enabled
+func (r *ModuleForEachMapRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *ModuleForEachMapRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns a link to detailed information about the rule
+func (r *ModuleForEachMapRule) Link() string {
+	return r.link(r.Name())
+}
+
+// Check executes the rule checking process
+func (r *ModuleForEachMapRule) Check(runner tflint.Runner) error {
+	files, fileNames, err := sortedFiles(runner)
+	if err != nil {
+		return err
+	}
+
+	for _, fileName := range fileNames {
+		body, ok := files[fileName].Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		for _, block := range body.Blocks {
+			if block.Type != "module" || len(block.Labels) == 0 {
+				continue
+			}
+
+			forEachAttr, exists := block.Body.Attributes["for_each"]
+			if !exists {
+				continue
+			}
+			if _, isList := forEachAttr.Expr.(*hclsyntax.TupleConsExpr); !isList {
+				continue
+			}
+
+			err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("Module \"%s\" uses for_each with a list; use a map or set for stable keys", block.Labels[0]),
+				forEachAttr.Range(),
+			)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestModuleForEachMapRule(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected helper.Issues
+	}{
+		{
+			name: "list-based for_each",
+			content: `
+module "bucket" {
+  source   = "./modules/bucket"
+  for_each = ["logs", "assets"]
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewModuleForEachMapRule(),
+					Message: "Module \"bucket\" uses for_each with a list; use a map or set for stable keys",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 4, Column: 3},
+						End:      hcl.Pos{Line: 4, Column: 32},
+					},
+				},
+			},
+		},
+		{
+			name: "map-based for_each",
+			content: `
+module "bucket" {
+  source = "./modules/bucket"
+  for_each = {
+    logs   = "private"
+    assets = "public-read"
+  }
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "set-based for_each",
+			content: `
+module "bucket" {
+  source   = "./modules/bucket"
+  for_each = toset(["logs", "assets"])
+}`,
+			expected: helper.Issues{},
+		},
+	}
+
+	rule := NewModuleForEachMapRule()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": test.content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// ModuleGitRefTagRule checks that git module sources pin a tag or commit SHA rather than a branch
+type ModuleGitRefTagRule struct {
+	tflint.DefaultRule
+	docsLink
+}
+
+// NewModuleGitRefTagRule creates a new rule instance
+func NewModuleGitRefTagRule() *ModuleGitRefTagRule {
+	return &ModuleGitRefTagRule{}
+}
+
+// Name returns the rule name
+func (r *ModuleGitRefTagRule) Name() string {
+	return "module_git_ref_tag"
+}
+
+// Enabled returns whether the rule is enabled
+func (r *ModuleGitRefTagRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *ModuleGitRefTagRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns a link to detailed information about the rule
+func (r *ModuleGitRefTagRule) Link() string {
+	return r.link(r.Name())
+}
+
+// Check executes the rule checking process
+func (r *ModuleGitRefTagRule) Check(runner tflint.Runner) error {
+	files, fileNames, err := sortedFiles(runner)
+	if err != nil {
+		return err
+	}
+
+	for _, fileName := range fileNames {
+		body, ok := files[fileName].Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		for _, block := range body.Blocks {
+			if block.Type != "module" || len(block.Labels) == 0 {
+				continue
+			}
+
+			sourceAttr, exists := block.Body.Attributes["source"]
+			if !exists {
+				continue
+			}
+			source, ok := literalString(sourceAttr.Expr)
+			if !ok || !isGitSource(source) {
+				continue
+			}
+
+			// Sources without a ref are reported by module_pinned_version
+			ref, ok := gitRef(source)
+			if !ok || ref == "" || gitTagPattern.MatchString(ref) || gitSHAPattern.MatchString(ref) {
+				continue
+			}
+
+			err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("Module \"%s\" pins to branch \"%s\"; pin to a tag or SHA", block.Labels[0], ref),
+				sourceAttr.Range(),
+			)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestModuleGitRefTagRule(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected helper.Issues
+	}{
+		{
+			name: "tag ref",
+			content: `
+module "vpc" {
+  source = "git::https://example.com/vpc.git?ref=v1.2.0"
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "sha ref",
+			content: `
+module "vpc" {
+  source = "git::https://example.com/vpc.git?ref=51d462976d84fdea54b47d80dcabbf680badcdb8"
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "branch ref",
+			content: `
+module "vpc" {
+  source = "git::https://example.com/vpc.git?ref=main"
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewModuleGitRefTagRule(),
+					Message: "Module \"vpc\" pins to branch \"main\"; pin to a tag or SHA",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 55},
+					},
+				},
+			},
+		},
+		{
+			name: "no ref",
+			content: `
+module "vpc" {
+  source = "git::https://example.com/vpc.git"
+}`,
+			expected: helper.Issues{},
+		},
+	}
+
+	rule := NewModuleGitRefTagRule()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": test.content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.expected, runner.Issues)
+		})
+	}
+}
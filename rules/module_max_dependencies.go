@@ -0,0 +1,109 @@
+package rules
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// defaultMaxDependencies is the fan-out limit used when max is not configured
+const defaultMaxDependencies = 10
+
+// ModuleMaxDependenciesRule checks that a module does not depend on too many other modules
+type ModuleMaxDependenciesRule struct {
+	tflint.DefaultRule
+	docsLink
+}
+
+// moduleMaxDependenciesRuleConfig is the configuration for the rule
+type moduleMaxDependenciesRuleConfig struct {
+	Max int `hclext:"max,optional"`
+}
+
+// NewModuleMaxDependenciesRule creates a new rule instance
+func NewModuleMaxDependenciesRule() *ModuleMaxDependenciesRule {
+	return &ModuleMaxDependenciesRule{}
+}
+
+// Name returns the rule name
+func (r *ModuleMaxDependenciesRule) Name() string {
+	return "module_max_dependencies"
+}
+
+// Enabled returns whether the rule is enabled
+func (r *ModuleMaxDependenciesRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *ModuleMaxDependenciesRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns a link to detailed information about the rule
+func (r *ModuleMaxDependenciesRule) Link() string {
+	return r.link(r.Name())
+}
+
+// Check executes the rule checking process
+func (r *ModuleMaxDependenciesRule) Check(runner tflint.Runner) error {
+	// Decode rule configuration
+	config := moduleMaxDependenciesRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+	limit := config.Max
+	if limit <= 0 {
+		limit = defaultMaxDependencies
+	}
+
+	files, fileNames, err := sortedFiles(runner)
+	if err != nil {
+		return err
+	}
+
+	graph, err := parseModuleGraph(files, fileNames)
+	if err != nil {
+		return err
+	}
+	modules := graph.modules()
+	dependencies := graph.dependencies(modules)
+
+	// Count distinct downstream modules, ignoring self-references
+	downstream := make(map[string]map[string]bool)
+	for _, dep := range dependencies {
+		if dep.From == dep.To {
+			continue
+		}
+		if downstream[dep.From] == nil {
+			downstream[dep.From] = make(map[string]bool)
+		}
+		downstream[dep.From][dep.To] = true
+	}
+
+	// Sort module names for deterministic order
+	var moduleNames []string
+	for name := range downstream {
+		moduleNames = append(moduleNames, name)
+	}
+	sort.Strings(moduleNames)
+
+	for _, name := range moduleNames {
+		count := len(downstream[name])
+		if count <= limit {
+			continue
+		}
+
+		err := runner.EmitIssue(
+			r,
+			fmt.Sprintf("Module \"%s\" depends on %d modules, exceeding the limit of %d", name, count, limit),
+			modules[name].DefRange,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
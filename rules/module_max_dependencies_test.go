@@ -0,0 +1,85 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestModuleMaxDependenciesRule(t *testing.T) {
+	content := `
+module "module_a" {
+  source = "./modules/a"
+  b = module.module_b.output
+  c = module.module_c.output
+}
+
+module "module_b" {
+  source = "./modules/b"
+}
+
+module "module_c" {
+  source = "./modules/c"
+}`
+
+	tests := []struct {
+		name     string
+		content  string
+		config   string
+		expected helper.Issues
+	}{
+		{
+			name:     "below the default limit",
+			content:  content,
+			expected: helper.Issues{},
+		},
+		{
+			name:    "at the limit",
+			content: content,
+			config: `
+rule "module_max_dependencies" {
+  enabled = true
+  max = 2
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name:    "above the limit",
+			content: content,
+			config: `
+rule "module_max_dependencies" {
+  enabled = true
+  max = 1
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewModuleMaxDependenciesRule(),
+					Message: "Module \"module_a\" depends on 2 modules, exceeding the limit of 1",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 18},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewModuleMaxDependenciesRule()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			files := map[string]string{"main.tf": test.content}
+			if test.config != "" {
+				files[".tflint.hcl"] = test.config
+			}
+			runner := helper.TestRunner(t, files)
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// ModuleMissingSourceRule checks that every module block has a source attribute
+type ModuleMissingSourceRule struct {
+	tflint.DefaultRule
+	docsLink
+}
+
+// NewModuleMissingSourceRule creates a new rule instance
+func NewModuleMissingSourceRule() *ModuleMissingSourceRule {
+	return &ModuleMissingSourceRule{}
+}
+
+// Name returns the rule name
+func (r *ModuleMissingSourceRule) Name() string {
+	return "module_missing_source"
+}
+
+// Enabled returns whether the rule is enabled
+func (r *ModuleMissingSourceRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *ModuleMissingSourceRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns a link to detailed information about the rule
+func (r *ModuleMissingSourceRule) Link() string {
+	return r.link(r.Name())
+}
+
+// Check executes the rule checking process
+func (r *ModuleMissingSourceRule) Check(runner tflint.Runner) error {
+	files, fileNames, err := sortedFiles(runner)
+	if err != nil {
+		return err
+	}
+
+	for _, fileName := range fileNames {
+		body, ok := files[fileName].Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		for _, block := range body.Blocks {
+			if block.Type != "module" || len(block.Labels) == 0 {
+				continue
+			}
+
+			if _, exists := block.Body.Attributes["source"]; exists {
+				continue
+			}
+
+			err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("Module \"%s\" is missing a source attribute", block.Labels[0]),
+				block.DefRange(),
+			)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestModuleMissingSourceRule(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected helper.Issues
+	}{
+		{
+			name: "module with source",
+			content: `
+module "module_a" {
+  source = "./modules/a"
+  input = "value"
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "module without source",
+			content: `
+module "module_a" {
+  input = "value"
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewModuleMissingSourceRule(),
+					Message: "Module \"module_a\" is missing a source attribute",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 18},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewModuleMissingSourceRule()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": test.content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package rules
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// ModuleNameMatchesSourceRule checks that local modules are named after their source directory
+type ModuleNameMatchesSourceRule struct {
+	tflint.DefaultRule
+	docsLink
+}
+
+// NewModuleNameMatchesSourceRule creates a new rule instance
+func NewModuleNameMatchesSourceRule() *ModuleNameMatchesSourceRule {
+	return &ModuleNameMatchesSourceRule{}
+}
+
+// Name returns the rule name
+func (r *ModuleNameMatchesSourceRule) Name() string {
+	return "module_name_matches_source"
+}
+
+// Enabled returns whether the rule is enabled
+func (r *ModuleNameMatchesSourceRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *ModuleNameMatchesSourceRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns a link to detailed information about the rule
+func (r *ModuleNameMatchesSourceRule) Link() string {
+	return r.link(r.Name())
+}
+
+// Check executes the rule checking process
+func (r *ModuleNameMatchesSourceRule) Check(runner tflint.Runner) error {
+	files, fileNames, err := sortedFiles(runner)
+	if err != nil {
+		return err
+	}
+
+	for _, fileName := range fileNames {
+		body, ok := files[fileName].Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		for _, block := range body.Blocks {
+			if block.Type != "module" || len(block.Labels) == 0 {
+				continue
+			}
+
+			sourceAttr, exists := block.Body.Attributes["source"]
+			if !exists {
+				continue
+			}
+			source, ok := literalString(sourceAttr.Expr)
+			if !ok || !isLocalSource(source) {
+				continue
+			}
+
+			// Hyphenated directories (api-gateway) match underscored names (api_gateway)
+			dirName := path.Base(path.Clean(source))
+			if strings.ReplaceAll(dirName, "-", "_") == strings.ReplaceAll(block.Labels[0], "-", "_") {
+				continue
+			}
+
+			err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("Module \"%s\" sources from \"%s\"; consider matching names", block.Labels[0], source),
+				sourceAttr.Range(),
+			)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
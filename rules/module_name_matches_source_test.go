@@ -0,0 +1,69 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestModuleNameMatchesSourceRule(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected helper.Issues
+	}{
+		{
+			name: "matching name",
+			content: `
+module "api" {
+  source = "./modules/api"
+}
+
+module "api_gateway" {
+  source = "./modules/api-gateway/"
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "mismatching name",
+			content: `
+module "web" {
+  source = "./modules/api"
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewModuleNameMatchesSourceRule(),
+					Message: "Module \"web\" sources from \"./modules/api\"; consider matching names",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 27},
+					},
+				},
+			},
+		},
+		{
+			name: "registry module",
+			content: `
+module "network" {
+  source  = "terraform-aws-modules/vpc/aws"
+  version = "5.1.0"
+}`,
+			expected: helper.Issues{},
+		},
+	}
+
+	rule := NewModuleNameMatchesSourceRule()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": test.content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.expected, runner.Issues)
+		})
+	}
+}
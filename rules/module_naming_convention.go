@@ -0,0 +1,121 @@
+package rules
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// namingFormats holds the patterns of the predefined naming formats
+var namingFormats = map[string]*regexp.Regexp{
+	"snake_case": regexp.MustCompile(`^[a-z][a-z0-9]*(_[a-z0-9]+)*$`),
+	"kebab-case": regexp.MustCompile(`^[a-z][a-z0-9]*(-[a-z0-9]+)*$`),
+}
+
+// namingPattern resolves the pattern to validate names against and a label used in messages
+// A custom regex takes precedence over the predefined format
+func namingPattern(format string, custom string) (*regexp.Regexp, string, error) {
+	if custom != "" {
+		pattern, err := regexp.Compile(custom)
+		if err != nil {
+			return nil, "", fmt.Errorf("invalid regex %q: %w", custom, err)
+		}
+		return pattern, fmt.Sprintf("/%s/", custom), nil
+	}
+
+	if format == "" {
+		format = "snake_case"
+	}
+	pattern, exists := namingFormats[format]
+	if !exists {
+		return nil, "", fmt.Errorf("invalid format %q: must be snake_case or kebab-case", format)
+	}
+	return pattern, format, nil
+}
+
+// ModuleNamingConventionRule checks that module names follow a naming convention
+type ModuleNamingConventionRule struct {
+	tflint.DefaultRule
+	docsLink
+}
+
+// moduleNamingConventionRuleConfig is the configuration for the rule
+type moduleNamingConventionRuleConfig struct {
+	Format string `hclext:"format,optional"`
+	Regex  string `hclext:"regex,optional"`
+}
+
+// NewModuleNamingConventionRule creates a new rule instance
+func NewModuleNamingConventionRule() *ModuleNamingConventionRule {
+	return &ModuleNamingConventionRule{}
+}
+
+// Name returns the rule name
+func (r *ModuleNamingConventionRule) Name() string {
+	return "module_naming_convention"
+}
+
+// Enabled returns whether the rule is enabled
+func (r *ModuleNamingConventionRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *ModuleNamingConventionRule) Severity() tflint.Severity {
+	return tflint.NOTICE
+}
+
+// Link returns a link to detailed information about the rule
+func (r *ModuleNamingConventionRule) Link() string {
+	return r.link(r.Name())
+}
+
+// Check executes the rule checking process
+func (r *ModuleNamingConventionRule) Check(runner tflint.Runner) error {
+	// Decode rule configuration
+	config := moduleNamingConventionRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	pattern, label, err := namingPattern(config.Format, config.Regex)
+	if err != nil {
+		return err
+	}
+
+	files, fileNames, err := sortedFiles(runner)
+	if err != nil {
+		return err
+	}
+
+	for _, fileName := range fileNames {
+		body, ok := files[fileName].Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		for _, block := range body.Blocks {
+			if block.Type != "module" || len(block.Labels) == 0 {
+				continue
+			}
+
+			moduleName := block.Labels[0]
+			if pattern.MatchString(moduleName) {
+				continue
+			}
+
+			err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("Module name \"%s\" does not match %s", moduleName, label),
+				block.LabelRanges[0],
+			)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
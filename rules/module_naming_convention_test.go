@@ -0,0 +1,151 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestModuleNamingConventionRule(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		config   string
+		expected helper.Issues
+	}{
+		{
+			name: "snake_case by default",
+			content: `
+module "module_a" {
+  source = "./modules/a"
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "non-compliant name with default format",
+			content: `
+module "MyModule" {
+  source = "./modules/a"
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewModuleNamingConventionRule(),
+					Message: "Module name \"MyModule\" does not match snake_case",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 8},
+						End:      hcl.Pos{Line: 2, Column: 18},
+					},
+				},
+			},
+		},
+		{
+			name: "compliant kebab-case name",
+			content: `
+module "module-a" {
+  source = "./modules/a"
+}`,
+			config: `
+rule "module_naming_convention" {
+  enabled = true
+  format = "kebab-case"
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "non-compliant kebab-case name",
+			content: `
+module "module_a" {
+  source = "./modules/a"
+}`,
+			config: `
+rule "module_naming_convention" {
+  enabled = true
+  format = "kebab-case"
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewModuleNamingConventionRule(),
+					Message: "Module name \"module_a\" does not match kebab-case",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 8},
+						End:      hcl.Pos{Line: 2, Column: 18},
+					},
+				},
+			},
+		},
+		{
+			name: "compliant custom regex",
+			content: `
+module "team_network" {
+  source = "./modules/network"
+}`,
+			config: `
+rule "module_naming_convention" {
+  enabled = true
+  regex = "^team_[a-z]+$"
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "non-compliant custom regex",
+			content: `
+module "network" {
+  source = "./modules/network"
+}`,
+			config: `
+rule "module_naming_convention" {
+  enabled = true
+  regex = "^team_[a-z]+$"
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewModuleNamingConventionRule(),
+					Message: "Module name \"network\" does not match /^team_[a-z]+$/",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 8},
+						End:      hcl.Pos{Line: 2, Column: 17},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewModuleNamingConventionRule()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			files := map[string]string{"main.tf": test.content}
+			if test.config != "" {
+				files[".tflint.hcl"] = test.config
+			}
+			runner := helper.TestRunner(t, files)
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.expected, runner.Issues)
+		})
+	}
+}
+
+func TestModuleNamingConventionRuleInvalidFormat(t *testing.T) {
+	runner := helper.TestRunner(t, map[string]string{
+		"main.tf": `
+module "module_a" {
+  source = "./modules/a"
+}`,
+		".tflint.hcl": `
+rule "module_naming_convention" {
+  enabled = true
+  format = "PascalCase"
+}`,
+	})
+
+	if err := NewModuleNamingConventionRule().Check(runner); err == nil {
+		t.Fatal("Expected an error for an invalid format, got nil")
+	}
+}
@@ -0,0 +1,176 @@
+package rules
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// defaultMaxNestingDepth is the maximum depth used when max is not configured
+const defaultMaxNestingDepth = 3
+
+// ModuleNestingDepthRule checks that local modules are not nested too deeply
+type ModuleNestingDepthRule struct {
+	tflint.DefaultRule
+	docsLink
+}
+
+// moduleNestingDepthRuleConfig is the configuration for the rule
+type moduleNestingDepthRuleConfig struct {
+	Max int `hclext:"max,optional"`
+}
+
+// NewModuleNestingDepthRule creates a new rule instance
+func NewModuleNestingDepthRule() *ModuleNestingDepthRule {
+	return &ModuleNestingDepthRule{}
+}
+
+// Name returns the rule name
+func (r *ModuleNestingDepthRule) Name() string {
+	return "module_nesting_depth"
+}
+
+// Enabled returns whether the rule is enabled
+func (r *ModuleNestingDepthRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *ModuleNestingDepthRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns a link to detailed information about the rule
+func (r *ModuleNestingDepthRule) Link() string {
+	return r.link(r.Name())
+}
+
+// Check executes the rule checking process
+func (r *ModuleNestingDepthRule) Check(runner tflint.Runner) error {
+	// Decode rule configuration
+	config := moduleNestingDepthRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+	limit := config.Max
+	if limit <= 0 {
+		limit = defaultMaxNestingDepth
+	}
+
+	files, fileNames, err := sortedFiles(runner)
+	if err != nil {
+		return err
+	}
+
+	// Parsed module directories are shared between the module trees
+	parsed := make(map[string]map[string]*hcl.File)
+
+	for _, fileName := range fileNames {
+		body, ok := files[fileName].Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		for _, block := range body.Blocks {
+			if block.Type != "module" || len(block.Labels) == 0 {
+				continue
+			}
+			sourceAttr, exists := block.Body.Attributes["source"]
+			if !exists {
+				continue
+			}
+			dir, ok := localModuleDir(sourceAttr.Expr)
+			if !ok {
+				continue
+			}
+
+			depth, err := r.nestingDepth(dir, parsed, make(map[string]bool))
+			if err != nil {
+				return err
+			}
+			if depth <= limit {
+				continue
+			}
+
+			err = runner.EmitIssue(
+				r,
+				fmt.Sprintf("Module nesting for \"%s\" exceeds max depth of %d", block.Labels[0], limit),
+				sourceAttr.Range(),
+			)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// nestingDepth returns the depth of the module tree rooted at a local module directory
+// A module without nested local modules has a depth of 1
+// Directories already on the current path are not followed again, so local cycles terminate
+func (r *ModuleNestingDepthRule) nestingDepth(dir string, parsed map[string]map[string]*hcl.File, onPath map[string]bool) (int, error) {
+	onPath[dir] = true
+	defer delete(onPath, dir)
+
+	files, exists := parsed[dir]
+	if !exists {
+		var err error
+		files, err = parseModuleDir(dir)
+		if err != nil {
+			return 0, err
+		}
+		parsed[dir] = files
+	}
+
+	var fileNames []string
+	for name := range files {
+		fileNames = append(fileNames, name)
+	}
+	sort.Strings(fileNames)
+
+	deepest := 0
+	for _, name := range fileNames {
+		content, _, diags := files[name].Body.PartialContent(&hcl.BodySchema{
+			Blocks: []hcl.BlockHeaderSchema{
+				{Type: "module", LabelNames: []string{"name"}},
+			},
+		})
+		if diags.HasErrors() {
+			return 0, diags
+		}
+
+		for _, block := range content.Blocks {
+			attrs, _, diags := block.Body.PartialContent(&hcl.BodySchema{
+				Attributes: []hcl.AttributeSchema{{Name: "source"}},
+			})
+			if diags.HasErrors() {
+				return 0, diags
+			}
+			sourceAttr, exists := attrs.Attributes["source"]
+			if !exists {
+				continue
+			}
+			childDir, ok := localModuleDir(sourceAttr.Expr)
+			if !ok {
+				continue
+			}
+			if onPath[childDir] {
+				continue
+			}
+
+			depth, err := r.nestingDepth(childDir, parsed, onPath)
+			if err != nil {
+				return 0, err
+			}
+			if depth > deepest {
+				deepest = depth
+			}
+		}
+	}
+
+	return deepest + 1, nil
+}
@@ -0,0 +1,101 @@
+package rules
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestModuleNestingDepthRule(t *testing.T) {
+	dir := t.TempDir()
+	fixtures := map[string]string{
+		"leaf":   ``,
+		"mid":    "module \"leaf\" {\n  source = \"../leaf\"\n}\n",
+		"top":    "module \"mid\" {\n  source = \"../mid\"\n}\n",
+		"loop_a": "module \"loop_b\" {\n  source = \"../loop_b\"\n}\n",
+		"loop_b": "module \"loop_a\" {\n  source = \"../loop_a\"\n}\n",
+	}
+	for name, content := range fixtures {
+		if err := os.MkdirAll(filepath.Join(dir, "modules", name), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, "modules", name, "main.tf"), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	mainFile := filepath.Join(dir, "main.tf")
+	config := `
+rule "module_nesting_depth" {
+  enabled = true
+  max     = 2
+}`
+
+	tests := []struct {
+		name     string
+		content  string
+		expected helper.Issues
+	}{
+		{
+			name: "depth 1",
+			content: `
+module "leaf" {
+  source = "./modules/leaf"
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "depth 2",
+			content: `
+module "mid" {
+  source = "./modules/mid"
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "depth 3 exceeds max",
+			content: `
+module "top" {
+  source = "./modules/top"
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewModuleNestingDepthRule(),
+					Message: "Module nesting for \"top\" exceeds max depth of 2",
+					Range: hcl.Range{
+						Filename: mainFile,
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 27},
+					},
+				},
+			},
+		},
+		{
+			name: "local cycle terminates",
+			content: `
+module "loop" {
+  source = "./modules/loop_a"
+}`,
+			expected: helper.Issues{},
+		},
+	}
+
+	rule := NewModuleNestingDepthRule()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{
+				mainFile:      test.content,
+				".tflint.hcl": config,
+			})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.expected, runner.Issues)
+		})
+	}
+}
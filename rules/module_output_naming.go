@@ -0,0 +1,116 @@
+package rules
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// ModuleOutputNamingRule checks that output names follow a naming convention
+type ModuleOutputNamingRule struct {
+	tflint.DefaultRule
+	docsLink
+}
+
+// moduleOutputNamingRuleConfig is the configuration for the rule
+type moduleOutputNamingRuleConfig struct {
+	Format        string `hclext:"format,optional"`
+	Regex         string `hclext:"regex,optional"`
+	RequirePrefix bool   `hclext:"require_prefix,optional"`
+}
+
+// NewModuleOutputNamingRule creates a new rule instance
+func NewModuleOutputNamingRule() *ModuleOutputNamingRule {
+	return &ModuleOutputNamingRule{}
+}
+
+// Name returns the rule name
+func (r *ModuleOutputNamingRule) Name() string {
+	return "module_output_naming"
+}
+
+// Enabled returns whether the rule is enabled
+func (r *ModuleOutputNamingRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *ModuleOutputNamingRule) Severity() tflint.Severity {
+	return tflint.NOTICE
+}
+
+// Link returns a link to detailed information about the rule
+func (r *ModuleOutputNamingRule) Link() string {
+	return r.link(r.Name())
+}
+
+// Check executes the rule checking process
+func (r *ModuleOutputNamingRule) Check(runner tflint.Runner) error {
+	// Decode rule configuration
+	config := moduleOutputNamingRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	pattern, label, err := namingPattern(config.Format, config.Regex)
+	if err != nil {
+		return err
+	}
+
+	files, fileNames, err := sortedFiles(runner)
+	if err != nil {
+		return err
+	}
+
+	for _, fileName := range fileNames {
+		body, ok := files[fileName].Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		prefix := ""
+		if config.RequirePrefix {
+			prefix, err = r.modulePrefix(fileName)
+			if err != nil {
+				return err
+			}
+		}
+
+		for _, block := range body.Blocks {
+			if block.Type != "output" || len(block.Labels) == 0 {
+				continue
+			}
+
+			var message string
+			outputName := block.Labels[0]
+			switch {
+			case !pattern.MatchString(outputName):
+				message = fmt.Sprintf("Output \"%s\" does not match %s", outputName, label)
+			case !strings.HasPrefix(outputName, prefix):
+				message = fmt.Sprintf("Output \"%s\" must be prefixed with \"%s\"", outputName, prefix)
+			default:
+				continue
+			}
+
+			err := runner.EmitIssue(r, message, block.LabelRanges[0])
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// modulePrefix returns the prefix required for outputs of the module a file belongs to
+// The module name is the name of its directory, with hyphens replaced by underscores
+func (r *ModuleOutputNamingRule) modulePrefix(fileName string) (string, error) {
+	dir, err := filepath.Abs(filepath.Dir(fileName))
+	if err != nil {
+		return "", err
+	}
+	return strings.ReplaceAll(filepath.Base(dir), "-", "_") + "_", nil
+}
@@ -0,0 +1,91 @@
+package rules
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestModuleOutputNamingRule(t *testing.T) {
+	outputsFile := filepath.Join(t.TempDir(), "network", "outputs.tf")
+
+	tests := []struct {
+		name     string
+		content  string
+		config   string
+		expected helper.Issues
+	}{
+		{
+			name: "compliant output name",
+			content: `
+output "vpc_id" {
+  value = "vpc-123"
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "non-compliant output name",
+			content: `
+output "MyOutput" {
+  value = "vpc-123"
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewModuleOutputNamingRule(),
+					Message: "Output \"MyOutput\" does not match snake_case",
+					Range: hcl.Range{
+						Filename: outputsFile,
+						Start:    hcl.Pos{Line: 2, Column: 8},
+						End:      hcl.Pos{Line: 2, Column: 18},
+					},
+				},
+			},
+		},
+		{
+			name: "prefix required",
+			content: `
+output "network_vpc_id" {
+  value = "vpc-123"
+}
+
+output "subnet_ids" {
+  value = []
+}`,
+			config: `
+rule "module_output_naming" {
+  enabled        = true
+  require_prefix = true
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewModuleOutputNamingRule(),
+					Message: "Output \"subnet_ids\" must be prefixed with \"network_\"",
+					Range: hcl.Range{
+						Filename: outputsFile,
+						Start:    hcl.Pos{Line: 6, Column: 8},
+						End:      hcl.Pos{Line: 6, Column: 20},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewModuleOutputNamingRule()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			files := map[string]string{outputsFile: test.content}
+			if test.config != "" {
+				files[".tflint.hcl"] = test.config
+			}
+			runner := helper.TestRunner(t, files)
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package rules
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+var (
+	// exactVersionPattern matches a version constraint that allows a single version
+	exactVersionPattern = regexp.MustCompile(`^=?\s*v?\d+\.\d+\.\d+(-[0-9A-Za-z.-]+)?$`)
+	// gitTagPattern matches a git ref that looks like a version tag
+	gitTagPattern = regexp.MustCompile(`^v?\d+\.\d+(\.\d+)?(-[0-9A-Za-z.-]+)?$`)
+	// gitSHAPattern matches a git ref that looks like a commit SHA
+	gitSHAPattern = regexp.MustCompile(`^[0-9a-f]{7,40}$`)
+)
+
+// ModulePinnedVersionRule checks that module sources are pinned to an exact version
+type ModulePinnedVersionRule struct {
+	tflint.DefaultRule
+	docsLink
+}
+
+// NewModulePinnedVersionRule creates a new rule instance
+func NewModulePinnedVersionRule() *ModulePinnedVersionRule {
+	return &ModulePinnedVersionRule{}
+}
+
+// Name returns the rule name
+func (r *ModulePinnedVersionRule) Name() string {
+	return "module_pinned_version"
+}
+
+// Enabled returns whether the rule is enabled
+func (r *ModulePinnedVersionRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *ModulePinnedVersionRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns a link to detailed information about the rule
+func (r *ModulePinnedVersionRule) Link() string {
+	return r.link(r.Name())
+}
+
+// Check executes the rule checking process
+func (r *ModulePinnedVersionRule) Check(runner tflint.Runner) error {
+	files, fileNames, err := sortedFiles(runner)
+	if err != nil {
+		return err
+	}
+
+	for _, fileName := range fileNames {
+		body, ok := files[fileName].Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		for _, block := range body.Blocks {
+			if block.Type != "module" || len(block.Labels) == 0 {
+				continue
+			}
+			moduleName := block.Labels[0]
+
+			sourceAttr, exists := block.Body.Attributes["source"]
+			if !exists {
+				continue
+			}
+			source, ok := literalString(sourceAttr.Expr)
+			if !ok || isLocalSource(source) {
+				continue
+			}
+
+			// Git sources must pin a ref to a tag or commit SHA
+			if isGitSource(source) {
+				if !r.isPinnedGitRef(source) {
+					err := runner.EmitIssue(
+						r,
+						fmt.Sprintf("Module \"%s\" must pin a git ref to a tag or commit SHA", moduleName),
+						sourceAttr.Range(),
+					)
+					if err != nil {
+						return err
+					}
+				}
+				continue
+			}
+
+			// Other remote sources (http, s3, etc.) cannot be versioned
+			if strings.Contains(source, "::") || strings.Contains(source, "://") {
+				continue
+			}
+
+			// Registry sources must set an exact version constraint
+			message := fmt.Sprintf("Module \"%s\" must pin an exact version", moduleName)
+			versionAttr, exists := block.Body.Attributes["version"]
+			if !exists {
+				if err := runner.EmitIssue(r, message, block.DefRange()); err != nil {
+					return err
+				}
+				continue
+			}
+			version, ok := literalString(versionAttr.Expr)
+			if !ok || !exactVersionPattern.MatchString(strings.TrimSpace(version)) {
+				if err := runner.EmitIssue(r, message, versionAttr.Range()); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// isPinnedGitRef returns whether a git source has a ref pinned to a tag or commit SHA
+func (r *ModulePinnedVersionRule) isPinnedGitRef(source string) bool {
+	ref, ok := gitRef(source)
+	if !ok {
+		return false
+	}
+	return gitTagPattern.MatchString(ref) || gitSHAPattern.MatchString(ref)
+}
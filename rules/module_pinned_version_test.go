@@ -0,0 +1,136 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestModulePinnedVersionRule(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected helper.Issues
+	}{
+		{
+			name: "registry module with exact version",
+			content: `
+module "vpc" {
+  source  = "terraform-aws-modules/vpc/aws"
+  version = "5.1.0"
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "registry module with range constraint",
+			content: `
+module "vpc" {
+  source  = "terraform-aws-modules/vpc/aws"
+  version = "~> 5.1"
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewModulePinnedVersionRule(),
+					Message: "Module \"vpc\" must pin an exact version",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 4, Column: 3},
+						End:      hcl.Pos{Line: 4, Column: 21},
+					},
+				},
+			},
+		},
+		{
+			name: "registry module without version",
+			content: `
+module "vpc" {
+  source = "terraform-aws-modules/vpc/aws"
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewModulePinnedVersionRule(),
+					Message: "Module \"vpc\" must pin an exact version",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 13},
+					},
+				},
+			},
+		},
+		{
+			name: "git module with tag ref",
+			content: `
+module "vpc" {
+  source = "git::https://example.com/vpc.git?ref=v1.2.0"
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "git module with sha ref",
+			content: `
+module "vpc" {
+  source = "git::https://example.com/vpc.git?ref=51d462976d84fdea54b47d80dcabbf680badcdb8"
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "git module without ref",
+			content: `
+module "vpc" {
+  source = "git::https://example.com/vpc.git"
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewModulePinnedVersionRule(),
+					Message: "Module \"vpc\" must pin a git ref to a tag or commit SHA",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 46},
+					},
+				},
+			},
+		},
+		{
+			name: "git module with branch ref",
+			content: `
+module "vpc" {
+  source = "git::https://example.com/vpc.git?ref=main"
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewModulePinnedVersionRule(),
+					Message: "Module \"vpc\" must pin a git ref to a tag or commit SHA",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 55},
+					},
+				},
+			},
+		},
+		{
+			name: "local module",
+			content: `
+module "vpc" {
+  source = "./modules/vpc"
+}`,
+			expected: helper.Issues{},
+		},
+	}
+
+	rule := NewModulePinnedVersionRule()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": test.content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package rules
+
+import (
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// ModuleProviderBlockRule checks for suspicious provider pass-through on module blocks
+type ModuleProviderBlockRule struct {
+	tflint.DefaultRule
+	docsLink
+}
+
+// NewModuleProviderBlockRule creates a new rule instance
+func NewModuleProviderBlockRule() *ModuleProviderBlockRule {
+	return &ModuleProviderBlockRule{}
+}
+
+// Name returns the rule name
+func (r *ModuleProviderBlockRule) Name() string {
+	return "module_provider_block"
+}
+
+// Enabled returns whether the rule is enabled
+func (r *ModuleProviderBlockRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *ModuleProviderBlockRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns a link to detailed information about the rule
+func (r *ModuleProviderBlockRule) Link() string {
+	return r.link(r.Name())
+}
+
+// Check executes the rule checking process
+func (r *ModuleProviderBlockRule) Check(runner tflint.Runner) error {
+	files, fileNames, err := sortedFiles(runner)
+	if err != nil {
+		return err
+	}
+
+	for _, fileName := range fileNames {
+		body, ok := files[fileName].Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		for _, block := range body.Blocks {
+			if block.Type != "module" || len(block.Labels) == 0 {
+				continue
+			}
+
+			attr, exists := block.Body.Attributes["providers"]
+			if !exists {
+				continue
+			}
+
+			obj, ok := attr.Expr.(*hclsyntax.ObjectConsExpr)
+			if !ok {
+				continue
+			}
+
+			for _, item := range obj.Items {
+				// Passing an aliased provider (aws.secondary) is the intended use of providers
+				traversal, ok := item.ValueExpr.(*hclsyntax.ScopeTraversalExpr)
+				if !ok || len(traversal.Traversal) != 1 {
+					continue
+				}
+
+				err := runner.EmitIssue(
+					r,
+					"Provider configuration inside reusable module is discouraged",
+					hcl.RangeBetween(item.KeyExpr.Range(), item.ValueExpr.Range()),
+				)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
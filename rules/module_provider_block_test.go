@@ -0,0 +1,71 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestModuleProviderBlockRule(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected helper.Issues
+	}{
+		{
+			name: "plain module",
+			content: `
+module "module_a" {
+  source = "./modules/a"
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "aliased providers",
+			content: `
+module "module_a" {
+  source = "./modules/a"
+  providers = {
+    aws = aws.secondary
+  }
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "bare remap",
+			content: `
+module "module_a" {
+  source = "./modules/a"
+  providers = {
+    aws        = aws
+    aws.remote = aws.secondary
+  }
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewModuleProviderBlockRule(),
+					Message: "Provider configuration inside reusable module is discouraged",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 5, Column: 5},
+						End:      hcl.Pos{Line: 5, Column: 21},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewModuleProviderBlockRule()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": test.content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,143 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// ModuleProviderVersionRule checks that providers passed to modules have a version constraint
+type ModuleProviderVersionRule struct {
+	tflint.DefaultRule
+	docsLink
+}
+
+// NewModuleProviderVersionRule creates a new rule instance
+func NewModuleProviderVersionRule() *ModuleProviderVersionRule {
+	return &ModuleProviderVersionRule{}
+}
+
+// Name returns the rule name
+func (r *ModuleProviderVersionRule) Name() string {
+	return "module_provider_version"
+}
+
+// Enabled returns whether the rule is enabled
+func (r *ModuleProviderVersionRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *ModuleProviderVersionRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns a link to detailed information about the rule
+func (r *ModuleProviderVersionRule) Link() string {
+	return r.link(r.Name())
+}
+
+// Check executes the rule checking process
+func (r *ModuleProviderVersionRule) Check(runner tflint.Runner) error {
+	files, fileNames, err := sortedFiles(runner)
+	if err != nil {
+		return err
+	}
+
+	// Collect providers declared in terraform.required_providers with a version constraint
+	versioned := make(map[string]bool)
+	for _, fileName := range fileNames {
+		body, ok := files[fileName].Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		for _, block := range body.Blocks {
+			if block.Type != "terraform" {
+				continue
+			}
+			for _, inner := range block.Body.Blocks {
+				if inner.Type != "required_providers" {
+					continue
+				}
+				for name, attr := range inner.Body.Attributes {
+					if r.hasVersion(attr.Expr) {
+						versioned[name] = true
+					}
+				}
+			}
+		}
+	}
+
+	for _, fileName := range fileNames {
+		body, ok := files[fileName].Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		for _, block := range body.Blocks {
+			if block.Type != "module" || len(block.Labels) == 0 {
+				continue
+			}
+
+			providersAttr, exists := block.Body.Attributes["providers"]
+			if !exists {
+				continue
+			}
+			obj, ok := providersAttr.Expr.(*hclsyntax.ObjectConsExpr)
+			if !ok {
+				continue
+			}
+
+			reported := make(map[string]bool)
+			for _, item := range obj.Items {
+				// Values are provider references such as aws or aws.west
+				traversal, diags := hcl.AbsTraversalForExpr(item.ValueExpr)
+				if diags.HasErrors() {
+					continue
+				}
+
+				provider := traversal.RootName()
+				if versioned[provider] || reported[provider] {
+					continue
+				}
+				reported[provider] = true
+
+				err := runner.EmitIssue(
+					r,
+					fmt.Sprintf("Module \"%s\" passes provider \"%s\" but no version constraint is declared", block.Labels[0], provider),
+					providersAttr.Range(),
+				)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// hasVersion returns whether a required_providers entry declares a version
+// Both the object form ({ source = ..., version = ... }) and the legacy string form are supported
+func (r *ModuleProviderVersionRule) hasVersion(expr hcl.Expression) bool {
+	if version, ok := literalString(expr); ok {
+		return version != ""
+	}
+
+	obj, ok := expr.(*hclsyntax.ObjectConsExpr)
+	if !ok {
+		return false
+	}
+	for _, item := range obj.Items {
+		if hcl.ExprAsKeyword(item.KeyExpr) == "version" {
+			return true
+		}
+		if key, ok := literalString(item.KeyExpr); ok && key == "version" {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,87 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestModuleProviderVersionRule(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected helper.Issues
+	}{
+		{
+			name: "declared with version",
+			content: `
+terraform {
+  required_providers {
+    aws = {
+      source  = "hashicorp/aws"
+      version = "~> 5.0"
+    }
+  }
+}
+
+module "module_a" {
+  source = "./modules/a"
+  providers = {
+    aws = aws.west
+  }
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "declared without version",
+			content: `
+terraform {
+  required_providers {
+    aws = {
+      source = "hashicorp/aws"
+    }
+  }
+}
+
+module "module_a" {
+  source = "./modules/a"
+  providers = {
+    aws = aws.west
+  }
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewModuleProviderVersionRule(),
+					Message: "Module \"module_a\" passes provider \"aws\" but no version constraint is declared",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 12, Column: 3},
+						End:      hcl.Pos{Line: 14, Column: 4},
+					},
+				},
+			},
+		},
+		{
+			name: "no providers argument",
+			content: `
+module "module_a" {
+  source = "./modules/a"
+}`,
+			expected: helper.Issues{},
+		},
+	}
+
+	rule := NewModuleProviderVersionRule()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": test.content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.expected, runner.Issues)
+		})
+	}
+}
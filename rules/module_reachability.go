@@ -0,0 +1,127 @@
+package rules
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// ModuleReachabilityRule checks that every module is used by the root configuration or another module
+type ModuleReachabilityRule struct {
+	tflint.DefaultRule
+	docsLink
+}
+
+// moduleReachabilityRuleConfig is the configuration for the rule
+type moduleReachabilityRuleConfig struct {
+	Roots []string `hclext:"roots,optional"`
+}
+
+// NewModuleReachabilityRule creates a new rule instance
+func NewModuleReachabilityRule() *ModuleReachabilityRule {
+	return &ModuleReachabilityRule{}
+}
+
+// Name returns the rule name
+func (r *ModuleReachabilityRule) Name() string {
+	return "module_reachability"
+}
+
+// Enabled returns whether the rule is enabled
+func (r *ModuleReachabilityRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *ModuleReachabilityRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns a link to detailed information about the rule
+func (r *ModuleReachabilityRule) Link() string {
+	return r.link(r.Name())
+}
+
+// Check executes the rule checking process
+func (r *ModuleReachabilityRule) Check(runner tflint.Runner) error {
+	// Decode rule configuration
+	config := moduleReachabilityRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	files, fileNames, err := sortedFiles(runner)
+	if err != nil {
+		return err
+	}
+
+	graph, err := parseModuleGraph(files, fileNames)
+	if err != nil {
+		return err
+	}
+	modules := graph.modules()
+	dependencies := graph.dependencies(modules)
+
+	// Modules explicitly allowed as roots are always reachable
+	reachable := make(map[string]bool)
+	for _, root := range config.Roots {
+		reachable[root] = true
+	}
+
+	// Modules with incoming edges from another module are reachable
+	for _, dep := range dependencies {
+		if dep.From != dep.To {
+			reachable[dep.To] = true
+		}
+	}
+
+	// Modules consumed outside of module blocks (outputs, resources, locals, ...) are reachable
+	for _, fileName := range fileNames {
+		body, ok := files[fileName].Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		for _, attr := range body.Attributes {
+			for _, name := range findModuleReferences(attr.Expr, modules) {
+				reachable[name] = true
+			}
+		}
+		for _, block := range body.Blocks {
+			if block.Type == "module" {
+				continue
+			}
+			walkAttributes(block.Body, func(attr *hclsyntax.Attribute) {
+				for _, name := range findModuleReferences(attr.Expr, modules) {
+					reachable[name] = true
+				}
+			})
+		}
+	}
+
+	// Sort module names for deterministic order
+	var moduleNames []string
+	for name := range modules {
+		moduleNames = append(moduleNames, name)
+	}
+	sort.Strings(moduleNames)
+
+	for _, name := range moduleNames {
+		if reachable[name] {
+			continue
+		}
+
+		err := runner.EmitIssue(
+			r,
+			fmt.Sprintf("Module \"%s\" is unreachable from the root configuration", name),
+			modules[name].DefRange,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,95 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestModuleReachabilityRule(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		config   string
+		expected helper.Issues
+	}{
+		{
+			name: "module consumed by an output",
+			content: `
+module "module_a" {
+  source = "./modules/a"
+}
+
+output "value" {
+  value = module.module_a.output
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "module consumed by another module",
+			content: `
+module "module_a" {
+  source = "./modules/a"
+}
+
+module "module_b" {
+  source = "./modules/b"
+  input = module.module_a.output
+}
+
+output "value" {
+  value = module.module_b.output
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "orphaned module",
+			content: `
+module "module_a" {
+  source = "./modules/a"
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewModuleReachabilityRule(),
+					Message: "Module \"module_a\" is unreachable from the root configuration",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 18},
+					},
+				},
+			},
+		},
+		{
+			name: "orphaned module allowed as root",
+			content: `
+module "module_a" {
+  source = "./modules/a"
+}`,
+			config: `
+rule "module_reachability" {
+  enabled = true
+  roots = ["module_a"]
+}`,
+			expected: helper.Issues{},
+		},
+	}
+
+	rule := NewModuleReachabilityRule()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			files := map[string]string{"main.tf": test.content}
+			if test.config != "" {
+				files[".tflint.hcl"] = test.config
+			}
+			runner := helper.TestRunner(t, files)
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.expected, runner.Issues)
+		})
+	}
+}
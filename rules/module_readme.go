@@ -0,0 +1,125 @@
+package rules
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// defaultReadmeFilenames are the documentation file names accepted when filenames is not configured
+var defaultReadmeFilenames = []string{"README.md"}
+
+// ModuleReadmeRule checks that local module directories contain a README
+type ModuleReadmeRule struct {
+	tflint.DefaultRule
+	docsLink
+}
+
+// moduleReadmeRuleConfig is the configuration for the rule
+type moduleReadmeRuleConfig struct {
+	Filenames []string `hclext:"filenames,optional"`
+}
+
+// NewModuleReadmeRule creates a new rule instance
+func NewModuleReadmeRule() *ModuleReadmeRule {
+	return &ModuleReadmeRule{}
+}
+
+// Name returns the rule name
+func (r *ModuleReadmeRule) Name() string {
+	return "module_readme"
+}
+
+// Enabled returns whether the rule is enabled
+func (r *ModuleReadmeRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *ModuleReadmeRule) Severity() tflint.Severity {
+	return tflint.NOTICE
+}
+
+// Link returns a link to detailed information about the rule
+func (r *ModuleReadmeRule) Link() string {
+	return r.link(r.Name())
+}
+
+// Check executes the rule checking process
+func (r *ModuleReadmeRule) Check(runner tflint.Runner) error {
+	// Decode rule configuration
+	config := moduleReadmeRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+	if len(config.Filenames) == 0 {
+		config.Filenames = defaultReadmeFilenames
+	}
+
+	files, fileNames, err := sortedFiles(runner)
+	if err != nil {
+		return err
+	}
+
+	for _, fileName := range fileNames {
+		body, ok := files[fileName].Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		for _, block := range body.Blocks {
+			if block.Type != "module" || len(block.Labels) == 0 {
+				continue
+			}
+
+			sourceAttr, exists := block.Body.Attributes["source"]
+			if !exists {
+				continue
+			}
+			dir, ok := localModuleDir(sourceAttr.Expr)
+			if !ok {
+				continue
+			}
+			if info, err := os.Stat(dir); err != nil || !info.IsDir() {
+				// Missing directories are reported by module_directory_exists
+				continue
+			}
+
+			found, err := r.hasReadme(dir, config.Filenames)
+			if err != nil {
+				return err
+			}
+			if found {
+				continue
+			}
+
+			err = runner.EmitIssue(
+				r,
+				fmt.Sprintf("Module \"%s\" source directory lacks a README.md", block.Labels[0]),
+				sourceAttr.Range(),
+			)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// hasReadme returns whether the directory contains any of the documentation files
+func (r *ModuleReadmeRule) hasReadme(dir string, filenames []string) (bool, error) {
+	for _, name := range filenames {
+		_, err := os.Stat(filepath.Join(dir, name))
+		if err == nil {
+			return true, nil
+		}
+		if !os.IsNotExist(err) {
+			return false, err
+		}
+	}
+	return false, nil
+}
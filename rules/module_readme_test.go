@@ -0,0 +1,91 @@
+package rules
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestModuleReadmeRule(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"documented", "undocumented", "alternate"} {
+		if err := os.MkdirAll(filepath.Join(dir, "modules", name), 0o755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.WriteFile(filepath.Join(dir, "modules", "documented", "README.md"), []byte("# documented\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "modules", "alternate", "README.rst"), []byte("alternate\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	mainFile := filepath.Join(dir, "main.tf")
+
+	tests := []struct {
+		name     string
+		content  string
+		config   string
+		expected helper.Issues
+	}{
+		{
+			name: "module with README",
+			content: `
+module "documented" {
+  source = "./modules/documented"
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "module without README",
+			content: `
+module "undocumented" {
+  source = "./modules/undocumented"
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewModuleReadmeRule(),
+					Message: "Module \"undocumented\" source directory lacks a README.md",
+					Range: hcl.Range{
+						Filename: mainFile,
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 36},
+					},
+				},
+			},
+		},
+		{
+			name: "module with alternate doc name",
+			content: `
+module "alternate" {
+  source = "./modules/alternate"
+}`,
+			config: `
+rule "module_readme" {
+  enabled = true
+  filenames = ["README.md", "README.rst"]
+}`,
+			expected: helper.Issues{},
+		},
+	}
+
+	rule := NewModuleReadmeRule()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			files := map[string]string{mainFile: test.content}
+			if test.config != "" {
+				files[".tflint.hcl"] = test.config
+			}
+			runner := helper.TestRunner(t, files)
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.expected, runner.Issues)
+		})
+	}
+}
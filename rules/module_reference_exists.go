@@ -0,0 +1,87 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// ModuleReferenceExistsRule checks that module references point to declared modules
+type ModuleReferenceExistsRule struct {
+	tflint.DefaultRule
+	docsLink
+}
+
+// NewModuleReferenceExistsRule creates a new rule instance
+func NewModuleReferenceExistsRule() *ModuleReferenceExistsRule {
+	return &ModuleReferenceExistsRule{}
+}
+
+// Name returns the rule name
+func (r *ModuleReferenceExistsRule) Name() string {
+	return "module_reference_exists"
+}
+
+// Enabled returns whether the rule is enabled
+func (r *ModuleReferenceExistsRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *ModuleReferenceExistsRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns a link to detailed information about the rule
+func (r *ModuleReferenceExistsRule) Link() string {
+	return r.link(r.Name())
+}
+
+// Check executes the rule checking process
+func (r *ModuleReferenceExistsRule) Check(runner tflint.Runner) error {
+	files, fileNames, err := sortedFiles(runner)
+	if err != nil {
+		return err
+	}
+
+	graph, err := parseModuleGraph(files, fileNames)
+	if err != nil {
+		return err
+	}
+	modules := graph.modules()
+
+	for _, fileName := range fileNames {
+		body, ok := files[fileName].Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		var emitErr error
+		walkAttributes(body, func(attr *hclsyntax.Attribute) {
+			for _, traversal := range collectTraversals(attr.Expr, "module") {
+				if emitErr != nil {
+					return
+				}
+				name, ok := moduleNameFromTraversal(traversal)
+				if !ok {
+					continue
+				}
+				if _, exists := modules[name]; exists {
+					continue
+				}
+
+				emitErr = runner.EmitIssue(
+					r,
+					fmt.Sprintf("Reference to undeclared module \"%s\"", name),
+					traversal.SourceRange(),
+				)
+			}
+		})
+		if emitErr != nil {
+			return emitErr
+		}
+	}
+
+	return nil
+}
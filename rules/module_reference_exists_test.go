@@ -0,0 +1,64 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestModuleReferenceExistsRule(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected helper.Issues
+	}{
+		{
+			name: "valid reference",
+			content: `
+module "network" {
+  source = "./modules/network"
+}
+
+output "vpc_id" {
+  value = module.network.vpc_id
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "reference to undeclared module",
+			content: `
+module "network" {
+  source = "./modules/network"
+}
+
+output "vpc_id" {
+  value = module.netwrok.vpc_id
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewModuleReferenceExistsRule(),
+					Message: "Reference to undeclared module \"netwrok\"",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 7, Column: 11},
+						End:      hcl.Pos{Line: 7, Column: 32},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewModuleReferenceExistsRule()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": test.content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.expected, runner.Issues)
+		})
+	}
+}
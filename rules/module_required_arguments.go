@@ -0,0 +1,99 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// ModuleRequiredArgumentsRule checks that module calls pass a required set of arguments
+type ModuleRequiredArgumentsRule struct {
+	tflint.DefaultRule
+	docsLink
+}
+
+// moduleRequiredArgumentsRuleConfig is the configuration for the rule
+type moduleRequiredArgumentsRuleConfig struct {
+	Required []string `hclext:"required,optional"`
+	Exclude  []string `hclext:"exclude,optional"`
+}
+
+// NewModuleRequiredArgumentsRule creates a new rule instance
+func NewModuleRequiredArgumentsRule() *ModuleRequiredArgumentsRule {
+	return &ModuleRequiredArgumentsRule{}
+}
+
+// Name returns the rule name
+func (r *ModuleRequiredArgumentsRule) Name() string {
+	return "module_required_arguments"
+}
+
+// Enabled returns whether the rule is enabled
+func (r *ModuleRequiredArgumentsRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *ModuleRequiredArgumentsRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns a link to detailed information about the rule
+func (r *ModuleRequiredArgumentsRule) Link() string {
+	return r.link(r.Name())
+}
+
+// Check executes the rule checking process
+func (r *ModuleRequiredArgumentsRule) Check(runner tflint.Runner) error {
+	// Decode rule configuration
+	config := moduleRequiredArgumentsRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	excluded := make(map[string]bool)
+	for _, name := range config.Exclude {
+		excluded[name] = true
+	}
+
+	files, fileNames, err := sortedFiles(runner)
+	if err != nil {
+		return err
+	}
+
+	for _, fileName := range fileNames {
+		body, ok := files[fileName].Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		for _, block := range body.Blocks {
+			if block.Type != "module" || len(block.Labels) == 0 {
+				continue
+			}
+
+			moduleName := block.Labels[0]
+			if excluded[moduleName] {
+				continue
+			}
+
+			for _, argument := range config.Required {
+				if _, exists := block.Body.Attributes[argument]; exists {
+					continue
+				}
+
+				err := runner.EmitIssue(
+					r,
+					fmt.Sprintf("Module \"%s\" is missing required argument \"%s\"", moduleName, argument),
+					block.DefRange(),
+				)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
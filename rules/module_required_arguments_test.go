@@ -0,0 +1,96 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestModuleRequiredArgumentsRule(t *testing.T) {
+	config := `
+rule "module_required_arguments" {
+  enabled = true
+  required = ["environment", "tags"]
+  exclude = ["legacy"]
+}`
+
+	tests := []struct {
+		name     string
+		content  string
+		expected helper.Issues
+	}{
+		{
+			name: "all required arguments present",
+			content: `
+module "module_a" {
+  source      = "./modules/a"
+  environment = "production"
+  tags        = {}
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "required arguments missing",
+			content: `
+module "module_a" {
+  source = "./modules/a"
+  tags   = {}
+}
+
+module "module_b" {
+  source = "./modules/b"
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewModuleRequiredArgumentsRule(),
+					Message: "Module \"module_a\" is missing required argument \"environment\"",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 18},
+					},
+				},
+				{
+					Rule:    NewModuleRequiredArgumentsRule(),
+					Message: "Module \"module_b\" is missing required argument \"environment\"",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 7, Column: 1},
+						End:      hcl.Pos{Line: 7, Column: 18},
+					},
+				},
+				{
+					Rule:    NewModuleRequiredArgumentsRule(),
+					Message: "Module \"module_b\" is missing required argument \"tags\"",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 7, Column: 1},
+						End:      hcl.Pos{Line: 7, Column: 18},
+					},
+				},
+			},
+		},
+		{
+			name: "excluded module",
+			content: `
+module "legacy" {
+  source = "./modules/legacy"
+}`,
+			expected: helper.Issues{},
+		},
+	}
+
+	rule := NewModuleRequiredArgumentsRule()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": test.content, ".tflint.hcl": config})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// reservedModuleNames is the set of Terraform keywords that must not be used as module names
+var reservedModuleNames = map[string]bool{
+	"count":     true,
+	"for_each":  true,
+	"provider":  true,
+	"module":    true,
+	"var":       true,
+	"local":     true,
+	"data":      true,
+	"each":      true,
+	"self":      true,
+	"path":      true,
+	"terraform": true,
+}
+
+// ModuleReservedNameRule checks that module names do not shadow Terraform reserved words
+type ModuleReservedNameRule struct {
+	tflint.DefaultRule
+	docsLink
+}
+
+// NewModuleReservedNameRule creates a new rule instance
+func NewModuleReservedNameRule() *ModuleReservedNameRule {
+	return &ModuleReservedNameRule{}
+}
+
+// Name returns the rule name
+func (r *ModuleReservedNameRule) Name() string {
+	return "module_reserved_name"
+}
+
+// Enabled returns whether the rule is enabled
+func (r *ModuleReservedNameRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *ModuleReservedNameRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns a link to detailed information about the rule
+func (r *ModuleReservedNameRule) Link() string {
+	return r.link(r.Name())
+}
+
+// Check executes the rule checking process
+func (r *ModuleReservedNameRule) Check(runner tflint.Runner) error {
+	files, fileNames, err := sortedFiles(runner)
+	if err != nil {
+		return err
+	}
+
+	for _, fileName := range fileNames {
+		body, ok := files[fileName].Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		for _, block := range body.Blocks {
+			if block.Type != "module" || len(block.Labels) == 0 {
+				continue
+			}
+
+			moduleName := block.Labels[0]
+			if !reservedModuleNames[moduleName] {
+				continue
+			}
+
+			err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("Module name \"%s\" is a reserved word", moduleName),
+				block.LabelRanges[0],
+			)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestModuleReservedNameRule(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected helper.Issues
+	}{
+		{
+			name: "normal name",
+			content: `
+module "module_a" {
+  source = "./modules/a"
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "reserved name",
+			content: `
+module "count" {
+  source = "./modules/a"
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewModuleReservedNameRule(),
+					Message: "Module name \"count\" is a reserved word",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 8},
+						End:      hcl.Pos{Line: 2, Column: 15},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewModuleReservedNameRule()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": test.content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package rules
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// ModuleSourceEscapeRule checks that local module sources stay within the working directory
+type ModuleSourceEscapeRule struct {
+	tflint.DefaultRule
+	docsLink
+}
+
+// NewModuleSourceEscapeRule creates a new rule instance
+func NewModuleSourceEscapeRule() *ModuleSourceEscapeRule {
+	return &ModuleSourceEscapeRule{}
+}
+
+// Name returns the rule name
+func (r *ModuleSourceEscapeRule) Name() string {
+	return "module_source_escape"
+}
+
+// Enabled returns whether the rule is enabled
+func (r *ModuleSourceEscapeRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *ModuleSourceEscapeRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns a link to detailed information about the rule
+func (r *ModuleSourceEscapeRule) Link() string {
+	return r.link(r.Name())
+}
+
+// Check executes the rule checking process
+func (r *ModuleSourceEscapeRule) Check(runner tflint.Runner) error {
+	files, fileNames, err := sortedFiles(runner)
+	if err != nil {
+		return err
+	}
+
+	for _, fileName := range fileNames {
+		body, ok := files[fileName].Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		for _, block := range body.Blocks {
+			if block.Type != "module" || len(block.Labels) == 0 {
+				continue
+			}
+
+			sourceAttr, exists := block.Body.Attributes["source"]
+			if !exists {
+				continue
+			}
+			source, ok := literalString(sourceAttr.Expr)
+			if !ok || !isLocalSource(source) {
+				continue
+			}
+
+			// Resolve the source relative to the directory of the defining file
+			resolved := filepath.Clean(filepath.Join(filepath.Dir(sourceAttr.Range().Filename), source))
+			if resolved != ".." && !strings.HasPrefix(resolved, ".."+string(filepath.Separator)) {
+				continue
+			}
+
+			err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("Module \"%s\" source \"%s\" escapes the repository root", block.Labels[0], source),
+				sourceAttr.Range(),
+			)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
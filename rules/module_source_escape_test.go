@@ -0,0 +1,68 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestModuleSourceEscapeRule(t *testing.T) {
+	tests := []struct {
+		name     string
+		filename string
+		content  string
+		expected helper.Issues
+	}{
+		{
+			name:     "safe relative path",
+			filename: "main.tf",
+			content: `
+module "module_a" {
+  source = "./modules/a"
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name:     "sibling path",
+			filename: "envs/prod/main.tf",
+			content: `
+module "module_a" {
+  source = "../../modules/a"
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name:     "escaping path",
+			filename: "envs/prod/main.tf",
+			content: `
+module "module_a" {
+  source = "../../../../etc"
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewModuleSourceEscapeRule(),
+					Message: "Module \"module_a\" source \"../../../../etc\" escapes the repository root",
+					Range: hcl.Range{
+						Filename: "envs/prod/main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 29},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewModuleSourceEscapeRule()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{test.filename: test.content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.expected, runner.Issues)
+		})
+	}
+}
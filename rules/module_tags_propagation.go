@@ -0,0 +1,92 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// ModuleTagsPropagationRule checks that module calls pass the shared tags down through their tags argument
+type ModuleTagsPropagationRule struct {
+	tflint.DefaultRule
+	docsLink
+}
+
+// NewModuleTagsPropagationRule creates a new rule instance
+func NewModuleTagsPropagationRule() *ModuleTagsPropagationRule {
+	return &ModuleTagsPropagationRule{}
+}
+
+// Name returns the rule name
+func (r *ModuleTagsPropagationRule) Name() string {
+	return "module_tags_propagation"
+}
+
+// Enabled returns whether the rule is enabled
+func (r *ModuleTagsPropagationRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *ModuleTagsPropagationRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns a link to detailed information about the rule
+func (r *ModuleTagsPropagationRule) Link() string {
+	return r.link(r.Name())
+}
+
+// Check executes the rule checking process
+func (r *ModuleTagsPropagationRule) Check(runner tflint.Runner) error {
+	files, fileNames, err := sortedFiles(runner)
+	if err != nil {
+		return err
+	}
+
+	for _, fileName := range fileNames {
+		body, ok := files[fileName].Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		for _, block := range body.Blocks {
+			if block.Type != "module" || len(block.Labels) == 0 {
+				continue
+			}
+
+			rng := block.DefRange()
+			if tagsAttr, exists := block.Body.Attributes["tags"]; exists {
+				if r.referencesCommonTags(tagsAttr.Expr) {
+					continue
+				}
+				rng = tagsAttr.Range()
+			}
+
+			err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("Module \"%s\" does not propagate common tags", block.Labels[0]),
+				rng,
+			)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// referencesCommonTags returns whether an expression references local.common_tags or var.tags
+func (r *ModuleTagsPropagationRule) referencesCommonTags(expr hcl.Expression) bool {
+	for rootName, name := range map[string]string{"local": "common_tags", "var": "tags"} {
+		for _, traversal := range collectTraversals(expr, rootName) {
+			if ref, ok := referenceNameFromTraversal(traversal, rootName); ok && ref == name {
+				return true
+			}
+		}
+	}
+	return false
+}
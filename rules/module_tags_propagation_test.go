@@ -0,0 +1,81 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestModuleTagsPropagationRule(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected helper.Issues
+	}{
+		{
+			name: "propagated tags",
+			content: `
+module "network" {
+  source = "./modules/network"
+  tags   = local.common_tags
+}
+
+module "app" {
+  source = "./modules/app"
+  tags   = merge(var.tags, { Component = "app" })
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "hardcoded tags",
+			content: `
+module "network" {
+  source = "./modules/network"
+  tags   = { Team = "platform" }
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewModuleTagsPropagationRule(),
+					Message: "Module \"network\" does not propagate common tags",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 4, Column: 3},
+						End:      hcl.Pos{Line: 4, Column: 33},
+					},
+				},
+			},
+		},
+		{
+			name: "missing tags",
+			content: `
+module "network" {
+  source = "./modules/network"
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewModuleTagsPropagationRule(),
+					Message: "Module \"network\" does not propagate common tags",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 17},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewModuleTagsPropagationRule()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": test.content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.expected, runner.Issues)
+		})
+	}
+}
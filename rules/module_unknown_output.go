@@ -0,0 +1,180 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// ModuleUnknownOutputRule checks that module arguments only reference declared outputs of local modules
+type ModuleUnknownOutputRule struct {
+	tflint.DefaultRule
+	docsLink
+}
+
+// NewModuleUnknownOutputRule creates a new rule instance
+func NewModuleUnknownOutputRule() *ModuleUnknownOutputRule {
+	return &ModuleUnknownOutputRule{}
+}
+
+// Name returns the rule name
+func (r *ModuleUnknownOutputRule) Name() string {
+	return "module_unknown_output"
+}
+
+// Enabled returns whether the rule is enabled
+func (r *ModuleUnknownOutputRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *ModuleUnknownOutputRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns a link to detailed information about the rule
+func (r *ModuleUnknownOutputRule) Link() string {
+	return r.link(r.Name())
+}
+
+// Check executes the rule checking process
+func (r *ModuleUnknownOutputRule) Check(runner tflint.Runner) error {
+	files, fileNames, err := sortedFiles(runner)
+	if err != nil {
+		return err
+	}
+
+	// Resolve the outputs declared by each local module
+	outputs, err := localModuleOutputs(files, fileNames)
+	if err != nil {
+		return err
+	}
+
+	// Check output references in module arguments
+	for _, fileName := range fileNames {
+		body, ok := files[fileName].Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		for _, block := range body.Blocks {
+			if block.Type != "module" {
+				continue
+			}
+
+			var emitErr error
+			walkAttributes(block.Body, func(attr *hclsyntax.Attribute) {
+				for _, traversal := range attr.Expr.Variables() {
+					if emitErr != nil {
+						return
+					}
+
+					moduleName, output, ok := moduleOutputFromTraversal(traversal)
+					if !ok {
+						continue
+					}
+					declared, exists := outputs[moduleName]
+					if !exists || declared[output] {
+						continue
+					}
+
+					emitErr = runner.EmitIssue(
+						r,
+						fmt.Sprintf("Reference to undeclared output \"%s\" of module \"%s\"", output, moduleName),
+						traversal.SourceRange(),
+					)
+				}
+			})
+			if emitErr != nil {
+				return emitErr
+			}
+		}
+	}
+
+	return nil
+}
+
+// moduleOutputFromTraversal returns the module and output names of a module.module_name.output_name traversal
+// Instance keys of count/for_each modules are skipped over
+func moduleOutputFromTraversal(traversal hcl.Traversal) (string, string, bool) {
+	moduleName, ok := moduleNameFromTraversal(traversal)
+	if !ok {
+		return "", "", false
+	}
+
+	for _, step := range traversal[2:] {
+		switch s := step.(type) {
+		case hcl.TraverseIndex:
+			continue
+		case hcl.TraverseAttr:
+			return moduleName, s.Name, true
+		}
+		break
+	}
+	return "", "", false
+}
+
+// localModuleOutputs resolves the output names declared by each local module by module name
+// Modules whose directory does not exist or contains no Terraform files are left out
+func localModuleOutputs(files map[string]*hcl.File, fileNames []string) (map[string]map[string]bool, error) {
+	outputs := make(map[string]map[string]bool)
+	for _, fileName := range fileNames {
+		body, ok := files[fileName].Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		for _, block := range body.Blocks {
+			if block.Type != "module" || len(block.Labels) == 0 {
+				continue
+			}
+
+			sourceAttr, exists := block.Body.Attributes["source"]
+			if !exists {
+				continue
+			}
+			dir, ok := localModuleDir(sourceAttr.Expr)
+			if !ok {
+				continue
+			}
+
+			declared, err := moduleOutputs(dir)
+			if err != nil {
+				return nil, err
+			}
+			if declared != nil {
+				outputs[block.Labels[0]] = declared
+			}
+		}
+	}
+
+	return outputs, nil
+}
+
+// moduleOutputs returns the output names declared in a local module directory
+// It returns a nil map if the directory does not exist or contains no Terraform files
+func moduleOutputs(dir string) (map[string]bool, error) {
+	files, err := parseModuleDir(dir)
+	if err != nil || len(files) == 0 {
+		return nil, err
+	}
+
+	outputs := make(map[string]bool)
+	for _, file := range files {
+		content, _, diags := file.Body.PartialContent(&hcl.BodySchema{
+			Blocks: []hcl.BlockHeaderSchema{
+				{Type: "output", LabelNames: []string{"name"}},
+			},
+		})
+		if diags.HasErrors() {
+			return nil, diags
+		}
+		for _, block := range content.Blocks {
+			outputs[block.Labels[0]] = true
+		}
+	}
+
+	return outputs, nil
+}
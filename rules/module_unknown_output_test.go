@@ -0,0 +1,153 @@
+package rules
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestModuleUnknownOutputRule(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "modules", "network"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "modules", "network", "outputs.tf"), []byte(`
+output "vpc_id" {
+  value = "vpc-123"
+}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "modules", "network", "outputs.tf.json"), []byte(`{
+  "output": {
+    "vpc_cidr": {
+      "value": "10.0.0.0/16"
+    }
+  }
+}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.MkdirAll(filepath.Join(dir, "modules", "dns"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "modules", "dns", "main.tf.json"), []byte(`{
+  "output": {
+    "zone_id": {
+      "value": "Z123"
+    }
+  }
+}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	mainFile := filepath.Join(dir, "main.tf")
+
+	tests := []struct {
+		name     string
+		content  string
+		expected helper.Issues
+	}{
+		{
+			name: "valid output reference",
+			content: `
+module "network" {
+  source = "./modules/network"
+}
+
+module "app" {
+  source = "./modules/app"
+  vpc_id = module.network.vpc_id
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "output declared in a JSON file",
+			content: `
+module "network" {
+  source = "./modules/network"
+}
+
+module "app" {
+  source   = "./modules/app"
+  vpc_cidr = module.network.vpc_cidr
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "typo in output reference",
+			content: `
+module "network" {
+  source = "./modules/network"
+}
+
+module "app" {
+  source = "./modules/app"
+  vpc_id = module.network.vcp_id
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewModuleUnknownOutputRule(),
+					Message: "Reference to undeclared output \"vcp_id\" of module \"network\"",
+					Range: hcl.Range{
+						Filename: mainFile,
+						Start:    hcl.Pos{Line: 8, Column: 12},
+						End:      hcl.Pos{Line: 8, Column: 33},
+					},
+				},
+			},
+		},
+		{
+			name: "typo in output of a JSON-only module",
+			content: `
+module "dns" {
+  source = "./modules/dns"
+}
+
+module "app" {
+  source  = "./modules/app"
+  zone_id = module.dns.zone
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewModuleUnknownOutputRule(),
+					Message: "Reference to undeclared output \"zone\" of module \"dns\"",
+					Range: hcl.Range{
+						Filename: mainFile,
+						Start:    hcl.Pos{Line: 8, Column: 13},
+						End:      hcl.Pos{Line: 8, Column: 28},
+					},
+				},
+			},
+		},
+		{
+			name: "non-local module is skipped",
+			content: `
+module "network" {
+  source  = "terraform-aws-modules/vpc/aws"
+  version = "5.1.0"
+}
+
+module "app" {
+  source = "./modules/app"
+  vpc_id = module.network.anything
+}`,
+			expected: helper.Issues{},
+		},
+	}
+
+	rule := NewModuleUnknownOutputRule()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{mainFile: test.content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// ModuleUnusedOutputRule checks that every declared module is referenced somewhere
+type ModuleUnusedOutputRule struct {
+	tflint.DefaultRule
+	docsLink
+}
+
+// NewModuleUnusedOutputRule creates a new rule instance
+func NewModuleUnusedOutputRule() *ModuleUnusedOutputRule {
+	return &ModuleUnusedOutputRule{}
+}
+
+// Name returns the rule name
+func (r *ModuleUnusedOutputRule) Name() string {
+	return "module_unused_output"
+}
+
+// Enabled returns whether the rule is enabled
+func (r *ModuleUnusedOutputRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *ModuleUnusedOutputRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns a link to detailed information about the rule
+func (r *ModuleUnusedOutputRule) Link() string {
+	return r.link(r.Name())
+}
+
+// Check executes the rule checking process
+func (r *ModuleUnusedOutputRule) Check(runner tflint.Runner) error {
+	files, fileNames, err := sortedFiles(runner)
+	if err != nil {
+		return err
+	}
+
+	// Collect declared modules in declaration order
+	modules := make(map[string]ModuleInfo)
+	var moduleNames []string
+	declRanges := make(map[string]hcl.Range)
+	for _, fileName := range fileNames {
+		body, ok := files[fileName].Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		for _, block := range body.Blocks {
+			if block.Type == "module" && len(block.Labels) > 0 {
+				moduleName := block.Labels[0]
+				if _, exists := modules[moduleName]; !exists {
+					moduleNames = append(moduleNames, moduleName)
+					declRanges[moduleName] = block.DefRange()
+				}
+				modules[moduleName] = ModuleInfo{Name: moduleName}
+			}
+		}
+	}
+
+	// Track modules referenced from anywhere other than their own block
+	used := make(map[string]bool)
+	for _, fileName := range fileNames {
+		body, ok := files[fileName].Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		for _, attr := range body.Attributes {
+			for _, ref := range findModuleReferences(attr.Expr, modules) {
+				used[ref] = true
+			}
+		}
+
+		for _, block := range body.Blocks {
+			owner := ""
+			if block.Type == "module" && len(block.Labels) > 0 {
+				owner = block.Labels[0]
+			}
+
+			walkAttributes(block.Body, func(attr *hclsyntax.Attribute) {
+				for _, ref := range findModuleReferences(attr.Expr, modules) {
+					if ref != owner {
+						used[ref] = true
+					}
+				}
+			})
+		}
+	}
+
+	for _, moduleName := range moduleNames {
+		if used[moduleName] {
+			continue
+		}
+
+		err := runner.EmitIssue(
+			r,
+			fmt.Sprintf("Module \"%s\" is declared but its outputs are never used", moduleName),
+			declRanges[moduleName],
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,112 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestModuleUnusedOutputRule(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected helper.Issues
+	}{
+		{
+			name: "referenced module",
+			content: `
+module "module_a" {
+  source = "./modules/a"
+}
+
+output "value" {
+  value = module.module_a.output
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "module referenced in for_each, conditional and function call",
+			content: `
+module "module_a" {
+  source = "./modules/a"
+}
+
+module "module_b" {
+  source = "./modules/b"
+}
+
+module "module_c" {
+  source = "./modules/c"
+}
+
+resource "aws_instance" "web" {
+  for_each = toset(module.module_a.names)
+  ami      = var.enabled ? module.module_b.ami : "ami-default"
+  tags     = merge(module.module_c.tags, {})
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "module referenced in interpolation-only string",
+			content: `
+module "module_a" {
+  source = "./modules/a"
+}
+
+output "value" {
+  value = "${module.module_a.output}"
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "orphaned module",
+			content: `
+module "module_a" {
+  source = "./modules/a"
+}
+
+module "module_b" {
+  source = "./modules/b"
+  input = module.module_b.output
+}
+
+output "value" {
+  value = "static"
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewModuleUnusedOutputRule(),
+					Message: "Module \"module_a\" is declared but its outputs are never used",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 18},
+					},
+				},
+				{
+					Rule:    NewModuleUnusedOutputRule(),
+					Message: "Module \"module_b\" is declared but its outputs are never used",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 6, Column: 1},
+						End:      hcl.Pos{Line: 6, Column: 18},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewModuleUnusedOutputRule()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": test.content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.expected, runner.Issues)
+		})
+	}
+}
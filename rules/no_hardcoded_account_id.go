@@ -0,0 +1,107 @@
+package rules
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// accountIDPattern matches runs of 12 or more digits; only runs of exactly 12 are account IDs
+// Matching whole runs keeps adjacent IDs (e.g. "111111111111,222222222222") from sharing a delimiter
+var accountIDPattern = regexp.MustCompile(`\d{12,}`)
+
+// NoHardcodedAccountIDRule checks for AWS account IDs hardcoded in string literals
+type NoHardcodedAccountIDRule struct {
+	tflint.DefaultRule
+	docsLink
+}
+
+// noHardcodedAccountIDRuleConfig is the configuration for the rule
+type noHardcodedAccountIDRuleConfig struct {
+	Allow []string `hclext:"allow,optional"`
+}
+
+// NewNoHardcodedAccountIDRule creates a new rule instance
+func NewNoHardcodedAccountIDRule() *NoHardcodedAccountIDRule {
+	return &NoHardcodedAccountIDRule{}
+}
+
+// Name returns the rule name
+func (r *NoHardcodedAccountIDRule) Name() string {
+	return "no_hardcoded_account_id"
+}
+
+// Enabled returns whether the rule is enabled
+func (r *NoHardcodedAccountIDRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *NoHardcodedAccountIDRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns a link to detailed information about the rule
+func (r *NoHardcodedAccountIDRule) Link() string {
+	return r.link(r.Name())
+}
+
+// Check executes the rule checking process
+func (r *NoHardcodedAccountIDRule) Check(runner tflint.Runner) error {
+	// Decode rule configuration
+	config := noHardcodedAccountIDRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	allowed := make(map[string]bool)
+	for _, id := range config.Allow {
+		allowed[id] = true
+	}
+
+	files, fileNames, err := sortedFiles(runner)
+	if err != nil {
+		return err
+	}
+
+	for _, fileName := range fileNames {
+		body, ok := files[fileName].Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		var emitErr error
+		walkAttributes(body, func(attr *hclsyntax.Attribute) {
+			for _, lit := range literalStrings(attr.Expr) {
+				if emitErr != nil {
+					return
+				}
+				if !r.containsAccountID(lit.Val.AsString(), allowed) {
+					continue
+				}
+
+				emitErr = runner.EmitIssue(
+					r,
+					"Hardcoded AWS account ID detected; use a variable or data source",
+					lit.Range(),
+				)
+			}
+		})
+		if emitErr != nil {
+			return emitErr
+		}
+	}
+
+	return nil
+}
+
+// containsAccountID returns whether a string contains an account ID that is not allowed
+func (r *NoHardcodedAccountIDRule) containsAccountID(s string, allowed map[string]bool) bool {
+	for _, match := range accountIDPattern.FindAllString(s, -1) {
+		if len(match) == 12 && !allowed[match] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,129 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestNoHardcodedAccountIDRule(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		config   string
+		expected helper.Issues
+	}{
+		{
+			name: "literal account ID",
+			content: `
+module "module_a" {
+  source     = "./modules/a"
+  account_id = "123456789012"
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewNoHardcodedAccountIDRule(),
+					Message: "Hardcoded AWS account ID detected; use a variable or data source",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 4, Column: 17},
+						End:      hcl.Pos{Line: 4, Column: 29},
+					},
+				},
+			},
+		},
+		{
+			name: "account ID inside an ARN template",
+			content: `
+resource "aws_iam_role_policy_attachment" "this" {
+  role       = "app"
+  policy_arn = "arn:aws:iam::123456789012:policy/${var.policy_name}"
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewNoHardcodedAccountIDRule(),
+					Message: "Hardcoded AWS account ID detected; use a variable or data source",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 4, Column: 17},
+						End:      hcl.Pos{Line: 4, Column: 50},
+					},
+				},
+			},
+		},
+		{
+			name: "variable reference",
+			content: `
+resource "aws_iam_role_policy_attachment" "this" {
+  role       = "app"
+  policy_arn = "arn:aws:iam::${var.account_id}:policy/app"
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "allowed account ID",
+			content: `
+module "module_a" {
+  source     = "./modules/a"
+  account_id = "123456789012"
+}`,
+			config: `
+rule "no_hardcoded_account_id" {
+  enabled = true
+  allow = ["123456789012"]
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "back-to-back account IDs",
+			content: `
+module "module_a" {
+  source      = "./modules/a"
+  account_ids = "111111111111,222222222222"
+}`,
+			config: `
+rule "no_hardcoded_account_id" {
+  enabled = true
+  allow = ["111111111111"]
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewNoHardcodedAccountIDRule(),
+					Message: "Hardcoded AWS account ID detected; use a variable or data source",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 4, Column: 18},
+						End:      hcl.Pos{Line: 4, Column: 43},
+					},
+				},
+			},
+		},
+		{
+			name: "longer numbers are not account IDs",
+			content: `
+module "module_a" {
+  source = "./modules/a"
+  number = "1234567890123"
+}`,
+			expected: helper.Issues{},
+		},
+	}
+
+	rule := NewNoHardcodedAccountIDRule()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			files := map[string]string{"main.tf": test.content}
+			if test.config != "" {
+				files[".tflint.hcl"] = test.config
+			}
+			runner := helper.TestRunner(t, files)
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.expected, runner.Issues)
+		})
+	}
+}
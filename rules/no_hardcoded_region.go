@@ -0,0 +1,96 @@
+package rules
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// regionPattern matches AWS regions (e.g. us-east-1, us-gov-west-1) and GCP regions (e.g. asia-northeast1)
+var regionPattern = regexp.MustCompile(`\b(?:(?:us|eu|ap|sa|ca|me|af|il|mx)(?:-gov)?-(?:north|south|east|west|central|northeast|southeast|northwest|southwest)-\d|(?:us|europe|asia|australia|northamerica|southamerica|me|africa)-(?:north|south|east|west|central|northeast|southeast|northwest|southwest)\d)\b`)
+
+// NoHardcodedRegionRule checks for region names hardcoded in string literals
+type NoHardcodedRegionRule struct {
+	tflint.DefaultRule
+	docsLink
+}
+
+// noHardcodedRegionRuleConfig is the configuration for the rule
+type noHardcodedRegionRuleConfig struct {
+	Allow []string `hclext:"allow,optional"`
+}
+
+// NewNoHardcodedRegionRule creates a new rule instance
+func NewNoHardcodedRegionRule() *NoHardcodedRegionRule {
+	return &NoHardcodedRegionRule{}
+}
+
+// Name returns the rule name
+func (r *NoHardcodedRegionRule) Name() string {
+	return "no_hardcoded_region"
+}
+
+// Enabled returns whether the rule is enabled
+func (r *NoHardcodedRegionRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *NoHardcodedRegionRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns a link to detailed information about the rule
+func (r *NoHardcodedRegionRule) Link() string {
+	return r.link(r.Name())
+}
+
+// Check executes the rule checking process
+func (r *NoHardcodedRegionRule) Check(runner tflint.Runner) error {
+	// Decode rule configuration
+	config := noHardcodedRegionRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	allowed := make(map[string]bool)
+	for _, region := range config.Allow {
+		allowed[region] = true
+	}
+
+	files, fileNames, err := sortedFiles(runner)
+	if err != nil {
+		return err
+	}
+
+	for _, fileName := range fileNames {
+		body, ok := files[fileName].Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		var emitErr error
+		walkAttributes(body, func(attr *hclsyntax.Attribute) {
+			for _, lit := range literalStrings(attr.Expr) {
+				for _, region := range regionPattern.FindAllString(lit.Val.AsString(), -1) {
+					if emitErr != nil || allowed[region] {
+						continue
+					}
+
+					emitErr = runner.EmitIssue(
+						r,
+						fmt.Sprintf("Hardcoded region \"%s\"; use a variable", region),
+						lit.Range(),
+					)
+				}
+			}
+		})
+		if emitErr != nil {
+			return emitErr
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,92 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestNoHardcodedRegionRule(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		config   string
+		expected helper.Issues
+	}{
+		{
+			name: "literal region",
+			content: `
+provider "aws" {
+  region = "us-east-1"
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewNoHardcodedRegionRule(),
+					Message: "Hardcoded region \"us-east-1\"; use a variable",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 13},
+						End:      hcl.Pos{Line: 3, Column: 22},
+					},
+				},
+			},
+		},
+		{
+			name: "region inside a larger string",
+			content: `
+resource "google_storage_bucket" "this" {
+  name = "logs-asia-northeast1-${var.env}"
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewNoHardcodedRegionRule(),
+					Message: "Hardcoded region \"asia-northeast1\"; use a variable",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 11},
+						End:      hcl.Pos{Line: 3, Column: 32},
+					},
+				},
+			},
+		},
+		{
+			name: "variable reference",
+			content: `
+provider "aws" {
+  region = var.region
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "allowed region",
+			content: `
+provider "aws" {
+  region = "us-east-1"
+}`,
+			config: `
+rule "no_hardcoded_region" {
+  enabled = true
+  allow = ["us-east-1"]
+}`,
+			expected: helper.Issues{},
+		},
+	}
+
+	rule := NewNoHardcodedRegionRule()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			files := map[string]string{"main.tf": test.content}
+			if test.config != "" {
+				files[".tflint.hcl"] = test.config
+			}
+			runner := helper.TestRunner(t, files)
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// NoLocalExecRule checks that resources do not use local-exec provisioners
+type NoLocalExecRule struct {
+	tflint.DefaultRule
+	docsLink
+}
+
+// noLocalExecRuleConfig is the configuration for the rule
+type noLocalExecRuleConfig struct {
+	Allow []string `hclext:"allow,optional"`
+}
+
+// NewNoLocalExecRule creates a new rule instance
+func NewNoLocalExecRule() *NoLocalExecRule {
+	return &NoLocalExecRule{}
+}
+
+// Name returns the rule name
+func (r *NoLocalExecRule) Name() string {
+	return "no_local_exec"
+}
+
+// Enabled returns whether the rule is enabled
+func (r *NoLocalExecRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *NoLocalExecRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns a link to detailed information about the rule
+func (r *NoLocalExecRule) Link() string {
+	return r.link(r.Name())
+}
+
+// Check executes the rule checking process
+func (r *NoLocalExecRule) Check(runner tflint.Runner) error {
+	// Decode rule configuration
+	config := noLocalExecRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+	allowed := make(map[string]bool)
+	for _, address := range config.Allow {
+		allowed[address] = true
+	}
+
+	files, fileNames, err := sortedFiles(runner)
+	if err != nil {
+		return err
+	}
+
+	for _, fileName := range fileNames {
+		body, ok := files[fileName].Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		for _, block := range body.Blocks {
+			if block.Type != "resource" || len(block.Labels) < 2 {
+				continue
+			}
+			address := fmt.Sprintf("%s.%s", block.Labels[0], block.Labels[1])
+			if allowed[address] {
+				continue
+			}
+
+			for _, inner := range block.Body.Blocks {
+				if inner.Type != "provisioner" || len(inner.Labels) == 0 || inner.Labels[0] != "local-exec" {
+					continue
+				}
+
+				err := runner.EmitIssue(
+					r,
+					fmt.Sprintf("Resource \"%s\" uses a local-exec provisioner; prefer a proper resource", address),
+					inner.DefRange(),
+				)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,92 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestNoLocalExecRule(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		config   string
+		expected helper.Issues
+	}{
+		{
+			name: "no provisioner",
+			content: `
+resource "aws_instance" "web" {
+  ami = "ami-12345678"
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "remote-exec provisioner",
+			content: `
+resource "aws_instance" "web" {
+  ami = "ami-12345678"
+
+  provisioner "remote-exec" {
+    inline = ["sudo systemctl start nginx"]
+  }
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "local-exec provisioner",
+			content: `
+resource "aws_instance" "web" {
+  ami = "ami-12345678"
+
+  provisioner "local-exec" {
+    command = "echo ${self.private_ip} >> hosts"
+  }
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewNoLocalExecRule(),
+					Message: "Resource \"aws_instance.web\" uses a local-exec provisioner; prefer a proper resource",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 5, Column: 3},
+						End:      hcl.Pos{Line: 5, Column: 27},
+					},
+				},
+			},
+		},
+		{
+			name: "allowed resource",
+			content: `
+resource "terraform_data" "bootstrap" {
+  provisioner "local-exec" {
+    command = "./scripts/bootstrap.sh"
+  }
+}`,
+			config: `
+rule "no_local_exec" {
+  enabled = true
+  allow   = ["terraform_data.bootstrap"]
+}`,
+			expected: helper.Issues{},
+		},
+	}
+
+	rule := NewNoLocalExecRule()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			files := map[string]string{"main.tf": test.content}
+			if test.config != "" {
+				files[".tflint.hcl"] = test.config
+			}
+			runner := helper.TestRunner(t, files)
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.expected, runner.Issues)
+		})
+	}
+}
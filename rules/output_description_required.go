@@ -0,0 +1,69 @@
+package rules
+
+import (
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// OutputDescriptionRequiredRule checks that every output has a description
+type OutputDescriptionRequiredRule struct {
+	tflint.DefaultRule
+	docsLink
+}
+
+// NewOutputDescriptionRequiredRule creates a new rule instance
+func NewOutputDescriptionRequiredRule() *OutputDescriptionRequiredRule {
+	return &OutputDescriptionRequiredRule{}
+}
+
+// Name returns the rule name
+func (r *OutputDescriptionRequiredRule) Name() string {
+	return "output_description_required"
+}
+
+// Enabled returns whether the rule is enabled
+func (r *OutputDescriptionRequiredRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *OutputDescriptionRequiredRule) Severity() tflint.Severity {
+	return tflint.NOTICE
+}
+
+// Link returns a link to detailed information about the rule
+func (r *OutputDescriptionRequiredRule) Link() string {
+	return r.link(r.Name())
+}
+
+// Check executes the rule checking process
+func (r *OutputDescriptionRequiredRule) Check(runner tflint.Runner) error {
+	files, fileNames, err := sortedFiles(runner)
+	if err != nil {
+		return err
+	}
+
+	for _, fileName := range fileNames {
+		body, ok := files[fileName].Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		for _, block := range body.Blocks {
+			if block.Type != "output" || len(block.Labels) == 0 {
+				continue
+			}
+
+			message, rng, ok := checkDescription("Output", block, 0)
+			if ok {
+				continue
+			}
+
+			if err := runner.EmitIssue(r, message, rng); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
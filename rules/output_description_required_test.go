@@ -0,0 +1,158 @@
+package rules
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestOutputDescriptionRequiredRule(t *testing.T) {
+	tests := []struct {
+		name     string
+		files    map[string]string
+		expected helper.Issues
+	}{
+		{
+			name: "described output",
+			files: map[string]string{
+				"outputs.tf": `
+output "instance_ip" {
+  description = "Public IP of the instance"
+  value       = "10.0.0.1"
+}`,
+			},
+			expected: helper.Issues{},
+		},
+		{
+			name: "undescribed output",
+			files: map[string]string{
+				"outputs.tf": `
+output "instance_ip" {
+  value = "10.0.0.1"
+}`,
+			},
+			expected: helper.Issues{
+				{
+					Rule:    NewOutputDescriptionRequiredRule(),
+					Message: "Output \"instance_ip\" is missing a description",
+					Range: hcl.Range{
+						Filename: "outputs.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 21},
+					},
+				},
+			},
+		},
+		{
+			name: "each output is reported once across files",
+			files: map[string]string{
+				"main.tf": `
+output "instance_id" {
+  value = "i-123456"
+}`,
+				"outputs.tf": `
+output "instance_ip" {
+  value = "10.0.0.1"
+}`,
+			},
+			expected: helper.Issues{
+				{
+					Rule:    NewOutputDescriptionRequiredRule(),
+					Message: "Output \"instance_id\" is missing a description",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 21},
+					},
+				},
+				{
+					Rule:    NewOutputDescriptionRequiredRule(),
+					Message: "Output \"instance_ip\" is missing a description",
+					Range: hcl.Range{
+						Filename: "outputs.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 21},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewOutputDescriptionRequiredRule()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, test.files)
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.expected, runner.Issues)
+		})
+	}
+}
+
+func TestOutputDescriptionRequiredRuleLocalSubmodule(t *testing.T) {
+	dir := t.TempDir()
+	moduleDir := filepath.Join(dir, "modules", "network")
+	if err := os.MkdirAll(moduleDir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	moduleContent := `
+output "vpc_id" {
+  value = "vpc-123"
+}`
+	moduleFile := filepath.Join(moduleDir, "outputs.tf")
+	if err := os.WriteFile(moduleFile, []byte(moduleContent), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	mainFile := filepath.Join(dir, "main.tf")
+	mainContent := `
+module "network" {
+  source = "./modules/network"
+}
+
+output "vpc_id" {
+  value = module.network.vpc_id
+}`
+
+	rule := NewOutputDescriptionRequiredRule()
+
+	// The root module reports only its own output, not the outputs of the local submodule
+	runner := helper.TestRunner(t, map[string]string{mainFile: mainContent})
+	if err := rule.Check(runner); err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+	helper.AssertIssues(t, helper.Issues{
+		{
+			Rule:    rule,
+			Message: "Output \"vpc_id\" is missing a description",
+			Range: hcl.Range{
+				Filename: mainFile,
+				Start:    hcl.Pos{Line: 6, Column: 1},
+				End:      hcl.Pos{Line: 6, Column: 16},
+			},
+		},
+	}, runner.Issues)
+
+	// The submodule output is reported once, when the submodule itself is inspected
+	runner = helper.TestRunner(t, map[string]string{moduleFile: moduleContent})
+	if err := rule.Check(runner); err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+	helper.AssertIssues(t, helper.Issues{
+		{
+			Rule:    rule,
+			Message: "Output \"vpc_id\" is missing a description",
+			Range: hcl.Range{
+				Filename: moduleFile,
+				Start:    hcl.Pos{Line: 2, Column: 1},
+				End:      hcl.Pos{Line: 2, Column: 16},
+			},
+		},
+	}, runner.Issues)
+}
@@ -0,0 +1,98 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// PreferForEachRule checks that resources use for_each instead of count
+type PreferForEachRule struct {
+	tflint.DefaultRule
+	docsLink
+}
+
+// preferForEachRuleConfig is the configuration for the rule
+type preferForEachRuleConfig struct {
+	ExcludeTypes          []string `hclext:"exclude_types,optional"`
+	AllowConditionalCount bool     `hclext:"allow_conditional_count,optional"`
+}
+
+// NewPreferForEachRule creates a new rule instance
+func NewPreferForEachRule() *PreferForEachRule {
+	return &PreferForEachRule{}
+}
+
+// Name returns the rule name
+func (r *PreferForEachRule) Name() string {
+	return "prefer_for_each"
+}
+
+// Enabled returns whether the rule is enabled
+func (r *PreferForEachRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *PreferForEachRule) Severity() tflint.Severity {
+	return tflint.NOTICE
+}
+
+// Link returns a link to detailed information about the rule
+func (r *PreferForEachRule) Link() string {
+	return r.link(r.Name())
+}
+
+// Check executes the rule checking process
+func (r *PreferForEachRule) Check(runner tflint.Runner) error {
+	// Decode rule configuration
+	config := preferForEachRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	excluded := make(map[string]bool)
+	for _, t := range config.ExcludeTypes {
+		excluded[t] = true
+	}
+
+	files, fileNames, err := sortedFiles(runner)
+	if err != nil {
+		return err
+	}
+
+	for _, fileName := range fileNames {
+		body, ok := files[fileName].Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		for _, block := range body.Blocks {
+			if block.Type != "resource" || len(block.Labels) < 2 || excluded[block.Labels[0]] {
+				continue
+			}
+
+			countAttr, exists := block.Body.Attributes["count"]
+			if !exists {
+				continue
+			}
+
+			// count = var.enabled ? 1 : 0 toggles a single instance rather than creating a list
+			if _, conditional := countAttr.Expr.(*hclsyntax.ConditionalExpr); conditional && config.AllowConditionalCount {
+				continue
+			}
+
+			err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("Resource \"%s.%s\" uses count; prefer for_each for stable addressing", block.Labels[0], block.Labels[1]),
+				countAttr.Range(),
+			)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
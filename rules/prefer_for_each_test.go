@@ -0,0 +1,110 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestPreferForEachRule(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		config   string
+		expected helper.Issues
+	}{
+		{
+			name: "plain count",
+			content: `
+resource "aws_instance" "web" {
+  count = 3
+  ami   = "ami-123456"
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewPreferForEachRule(),
+					Message: "Resource \"aws_instance.web\" uses count; prefer for_each for stable addressing",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 12},
+					},
+				},
+			},
+		},
+		{
+			name: "conditional count",
+			content: `
+resource "aws_instance" "web" {
+  count = var.enabled ? 1 : 0
+  ami   = "ami-123456"
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewPreferForEachRule(),
+					Message: "Resource \"aws_instance.web\" uses count; prefer for_each for stable addressing",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 30},
+					},
+				},
+			},
+		},
+		{
+			name: "conditional count with allow_conditional_count",
+			content: `
+resource "aws_instance" "web" {
+  count = var.enabled ? 1 : 0
+  ami   = "ami-123456"
+}`,
+			config: `
+rule "prefer_for_each" {
+  enabled = true
+  allow_conditional_count = true
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "excluded type",
+			content: `
+resource "aws_instance" "web" {
+  count = 3
+  ami   = "ami-123456"
+}`,
+			config: `
+rule "prefer_for_each" {
+  enabled = true
+  exclude_types = ["aws_instance"]
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "for_each",
+			content: `
+resource "aws_instance" "web" {
+  for_each = toset(["a", "b"])
+  ami      = "ami-123456"
+}`,
+			expected: helper.Issues{},
+		},
+	}
+
+	rule := NewPreferForEachRule()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			files := map[string]string{"main.tf": test.content}
+			if test.config != "" {
+				files[".tflint.hcl"] = test.config
+			}
+			runner := helper.TestRunner(t, files)
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.expected, runner.Issues)
+		})
+	}
+}
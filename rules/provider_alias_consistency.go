@@ -0,0 +1,129 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// defaultConsistentProviderAttributes are the attributes checked when attributes is not configured
+var defaultConsistentProviderAttributes = []string{"region"}
+
+// ProviderAliasConsistencyRule checks that aliased provider blocks set the attributes set on the default block
+type ProviderAliasConsistencyRule struct {
+	tflint.DefaultRule
+	docsLink
+}
+
+// providerAliasConsistencyRuleConfig is the configuration for the rule
+type providerAliasConsistencyRuleConfig struct {
+	Attributes []string `hclext:"attributes,optional"`
+}
+
+// NewProviderAliasConsistencyRule creates a new rule instance
+func NewProviderAliasConsistencyRule() *ProviderAliasConsistencyRule {
+	return &ProviderAliasConsistencyRule{}
+}
+
+// Name returns the rule name
+func (r *ProviderAliasConsistencyRule) Name() string {
+	return "provider_alias_consistency"
+}
+
+// Enabled returns whether the rule is enabled
+func (r *ProviderAliasConsistencyRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *ProviderAliasConsistencyRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns a link to detailed information about the rule
+func (r *ProviderAliasConsistencyRule) Link() string {
+	return r.link(r.Name())
+}
+
+// Check executes the rule checking process
+func (r *ProviderAliasConsistencyRule) Check(runner tflint.Runner) error {
+	// Decode rule configuration
+	config := providerAliasConsistencyRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+	if len(config.Attributes) == 0 {
+		config.Attributes = defaultConsistentProviderAttributes
+	}
+
+	files, fileNames, err := sortedFiles(runner)
+	if err != nil {
+		return err
+	}
+
+	// Collect the default (unaliased) block of each provider
+	defaults := make(map[string]*hclsyntax.Block)
+	for _, fileName := range fileNames {
+		body, ok := files[fileName].Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+		for _, block := range body.Blocks {
+			if block.Type != "provider" || len(block.Labels) == 0 {
+				continue
+			}
+			if _, aliased := block.Body.Attributes["alias"]; aliased {
+				continue
+			}
+			if _, exists := defaults[block.Labels[0]]; !exists {
+				defaults[block.Labels[0]] = block
+			}
+		}
+	}
+
+	for _, fileName := range fileNames {
+		body, ok := files[fileName].Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		for _, block := range body.Blocks {
+			if block.Type != "provider" || len(block.Labels) == 0 {
+				continue
+			}
+			aliasAttr, aliased := block.Body.Attributes["alias"]
+			if !aliased {
+				continue
+			}
+			defaultBlock, exists := defaults[block.Labels[0]]
+			if !exists {
+				continue
+			}
+			alias, ok := literalString(aliasAttr.Expr)
+			if !ok {
+				continue
+			}
+
+			for _, name := range config.Attributes {
+				if _, onDefault := defaultBlock.Body.Attributes[name]; !onDefault {
+					continue
+				}
+				if _, onAlias := block.Body.Attributes[name]; onAlias {
+					continue
+				}
+
+				err := runner.EmitIssue(
+					r,
+					fmt.Sprintf("Provider alias \"%s.%s\" is missing %s set on the default provider", block.Labels[0], alias, name),
+					block.DefRange(),
+				)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,99 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestProviderAliasConsistencyRule(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		config   string
+		expected helper.Issues
+	}{
+		{
+			name: "consistent aliases",
+			content: `
+provider "aws" {
+  region = "us-east-1"
+}
+
+provider "aws" {
+  alias  = "eu"
+  region = "eu-west-1"
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "alias missing region",
+			content: `
+provider "aws" {
+  region = "us-east-1"
+}
+
+provider "aws" {
+  alias = "eu"
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewProviderAliasConsistencyRule(),
+					Message: "Provider alias \"aws.eu\" is missing region set on the default provider",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 6, Column: 1},
+						End:      hcl.Pos{Line: 6, Column: 15},
+					},
+				},
+			},
+		},
+		{
+			name: "custom attributes",
+			content: `
+provider "aws" {
+  region  = "us-east-1"
+  profile = "prod"
+}
+
+provider "aws" {
+  alias  = "eu"
+  region = "eu-west-1"
+}`,
+			config: `
+rule "provider_alias_consistency" {
+  enabled    = true
+  attributes = ["region", "profile"]
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewProviderAliasConsistencyRule(),
+					Message: "Provider alias \"aws.eu\" is missing profile set on the default provider",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 7, Column: 1},
+						End:      hcl.Pos{Line: 7, Column: 15},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewProviderAliasConsistencyRule()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			files := map[string]string{"main.tf": test.content}
+			if test.config != "" {
+				files[".tflint.hcl"] = test.config
+			}
+			runner := helper.TestRunner(t, files)
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,168 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// ProviderAliasDefinedRule checks that referenced provider aliases are defined
+type ProviderAliasDefinedRule struct {
+	tflint.DefaultRule
+	docsLink
+}
+
+// NewProviderAliasDefinedRule creates a new rule instance
+func NewProviderAliasDefinedRule() *ProviderAliasDefinedRule {
+	return &ProviderAliasDefinedRule{}
+}
+
+// Name returns the rule name
+func (r *ProviderAliasDefinedRule) Name() string {
+	return "provider_alias_defined"
+}
+
+// Enabled returns whether the rule is enabled
+func (r *ProviderAliasDefinedRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *ProviderAliasDefinedRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns a link to detailed information about the rule
+func (r *ProviderAliasDefinedRule) Link() string {
+	return r.link(r.Name())
+}
+
+// Check executes the rule checking process
+func (r *ProviderAliasDefinedRule) Check(runner tflint.Runner) error {
+	files, fileNames, err := sortedFiles(runner)
+	if err != nil {
+		return err
+	}
+
+	// Collect aliases defined by provider blocks and, in child modules, by configuration_aliases
+	defined := make(map[string]bool)
+	for _, fileName := range fileNames {
+		body, ok := files[fileName].Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		for _, block := range body.Blocks {
+			if block.Type == "terraform" {
+				for alias := range r.configurationAliases(block) {
+					defined[alias] = true
+				}
+				continue
+			}
+			if block.Type != "provider" || len(block.Labels) == 0 {
+				continue
+			}
+			aliasAttr, exists := block.Body.Attributes["alias"]
+			if !exists {
+				continue
+			}
+			if alias, ok := literalString(aliasAttr.Expr); ok {
+				defined[block.Labels[0]+"."+alias] = true
+			}
+		}
+	}
+
+	for _, fileName := range fileNames {
+		body, ok := files[fileName].Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		for _, block := range body.Blocks {
+			var refs []hcl.Expression
+			switch block.Type {
+			case "resource", "data":
+				// provider = aws.secondary
+				if attr, exists := block.Body.Attributes["provider"]; exists {
+					refs = append(refs, attr.Expr)
+				}
+			case "module":
+				// providers = { aws = aws.secondary }
+				if attr, exists := block.Body.Attributes["providers"]; exists {
+					if obj, ok := attr.Expr.(*hclsyntax.ObjectConsExpr); ok {
+						for _, item := range obj.Items {
+							refs = append(refs, item.ValueExpr)
+						}
+					}
+				}
+			default:
+				continue
+			}
+
+			for _, expr := range refs {
+				traversal, diags := hcl.AbsTraversalForExpr(expr)
+				if diags.HasErrors() || len(traversal) < 2 {
+					// References to the default (unaliased) provider need no definition
+					continue
+				}
+				attr, ok := traversal[1].(hcl.TraverseAttr)
+				if !ok {
+					continue
+				}
+
+				alias := traversal.RootName() + "." + attr.Name
+				if defined[alias] {
+					continue
+				}
+
+				err := runner.EmitIssue(
+					r,
+					fmt.Sprintf("Provider alias \"%s\" is referenced but not defined", alias),
+					expr.Range(),
+				)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// configurationAliases returns the aliases a module expects from its caller via required_providers
+// e.g. aws = { source = "hashicorp/aws", configuration_aliases = [aws.east] } declares aws.east
+func (r *ProviderAliasDefinedRule) configurationAliases(block *hclsyntax.Block) map[string]bool {
+	aliases := make(map[string]bool)
+	for _, inner := range block.Body.Blocks {
+		if inner.Type != "required_providers" {
+			continue
+		}
+		for _, attr := range inner.Body.Attributes {
+			obj, ok := attr.Expr.(*hclsyntax.ObjectConsExpr)
+			if !ok {
+				continue
+			}
+			expr, exists := objectItem(obj, "configuration_aliases")
+			if !exists {
+				continue
+			}
+			tuple, ok := expr.(*hclsyntax.TupleConsExpr)
+			if !ok {
+				continue
+			}
+			for _, item := range tuple.Exprs {
+				traversal, diags := hcl.AbsTraversalForExpr(item)
+				if diags.HasErrors() || len(traversal) < 2 {
+					continue
+				}
+				if name, ok := traversal[1].(hcl.TraverseAttr); ok {
+					aliases[traversal.RootName()+"."+name.Name] = true
+				}
+			}
+		}
+	}
+	return aliases
+}
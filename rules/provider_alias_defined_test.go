@@ -0,0 +1,113 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestProviderAliasDefinedRule(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected helper.Issues
+	}{
+		{
+			name: "defined alias",
+			content: `
+provider "aws" {
+  alias  = "secondary"
+  region = "us-west-2"
+}
+
+resource "aws_instance" "web" {
+  provider = aws.secondary
+}
+
+module "module_a" {
+  source = "./modules/a"
+  providers = {
+    aws = aws.secondary
+  }
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "alias declared in configuration_aliases",
+			content: `
+terraform {
+  required_providers {
+    aws = {
+      source                = "hashicorp/aws"
+      configuration_aliases = [aws.east]
+    }
+  }
+}
+
+resource "aws_instance" "web" {
+  provider = aws.east
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "undefined alias",
+			content: `
+provider "aws" {
+  region = "us-west-2"
+}
+
+resource "aws_instance" "web" {
+  provider = aws.secondary
+}
+
+module "module_a" {
+  source = "./modules/a"
+  providers = {
+    aws = aws.tertiary
+  }
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewProviderAliasDefinedRule(),
+					Message: "Provider alias \"aws.secondary\" is referenced but not defined",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 7, Column: 14},
+						End:      hcl.Pos{Line: 7, Column: 27},
+					},
+				},
+				{
+					Rule:    NewProviderAliasDefinedRule(),
+					Message: "Provider alias \"aws.tertiary\" is referenced but not defined",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 13, Column: 11},
+						End:      hcl.Pos{Line: 13, Column: 23},
+					},
+				},
+			},
+		},
+		{
+			name: "default provider reference",
+			content: `
+resource "aws_instance" "web" {
+  provider = aws
+}`,
+			expected: helper.Issues{},
+		},
+	}
+
+	rule := NewProviderAliasDefinedRule()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": test.content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.expected, runner.Issues)
+		})
+	}
+}
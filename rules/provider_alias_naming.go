@@ -0,0 +1,96 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// ProviderAliasNamingRule checks that provider aliases follow a naming convention
+type ProviderAliasNamingRule struct {
+	tflint.DefaultRule
+	docsLink
+}
+
+// providerAliasNamingRuleConfig is the configuration for the rule
+type providerAliasNamingRuleConfig struct {
+	Format string `hclext:"format,optional"`
+	Regex  string `hclext:"regex,optional"`
+}
+
+// NewProviderAliasNamingRule creates a new rule instance
+func NewProviderAliasNamingRule() *ProviderAliasNamingRule {
+	return &ProviderAliasNamingRule{}
+}
+
+// Name returns the rule name
+func (r *ProviderAliasNamingRule) Name() string {
+	return "provider_alias_naming"
+}
+
+// Enabled returns whether the rule is enabled
+func (r *ProviderAliasNamingRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *ProviderAliasNamingRule) Severity() tflint.Severity {
+	return tflint.NOTICE
+}
+
+// Link returns a link to detailed information about the rule
+func (r *ProviderAliasNamingRule) Link() string {
+	return r.link(r.Name())
+}
+
+// Check executes the rule checking process
+func (r *ProviderAliasNamingRule) Check(runner tflint.Runner) error {
+	// Decode rule configuration
+	config := providerAliasNamingRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	pattern, label, err := namingPattern(config.Format, config.Regex)
+	if err != nil {
+		return err
+	}
+
+	files, fileNames, err := sortedFiles(runner)
+	if err != nil {
+		return err
+	}
+
+	for _, fileName := range fileNames {
+		body, ok := files[fileName].Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		for _, block := range body.Blocks {
+			if block.Type != "provider" {
+				continue
+			}
+			aliasAttr, exists := block.Body.Attributes["alias"]
+			if !exists {
+				continue
+			}
+			alias, ok := literalString(aliasAttr.Expr)
+			if !ok || pattern.MatchString(alias) {
+				continue
+			}
+
+			err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("Provider alias \"%s\" does not match %s", alias, label),
+				aliasAttr.Expr.Range(),
+			)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
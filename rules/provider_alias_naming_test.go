@@ -0,0 +1,77 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestProviderAliasNamingRule(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		config   string
+		expected helper.Issues
+	}{
+		{
+			name: "snake_case alias",
+			content: `
+provider "aws" {
+  alias  = "us_east_1"
+  region = "us-east-1"
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "camelCase alias",
+			content: `
+provider "aws" {
+  alias  = "MyAlias"
+  region = "us-east-1"
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewProviderAliasNamingRule(),
+					Message: "Provider alias \"MyAlias\" does not match snake_case",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 12},
+						End:      hcl.Pos{Line: 3, Column: 21},
+					},
+				},
+			},
+		},
+		{
+			name: "camelCase alias with custom regex",
+			content: `
+provider "aws" {
+  alias  = "MyAlias"
+  region = "us-east-1"
+}`,
+			config: `
+rule "provider_alias_naming" {
+  enabled = true
+  regex = "^[A-Z][a-zA-Z0-9]*$"
+}`,
+			expected: helper.Issues{},
+		},
+	}
+
+	rule := NewProviderAliasNamingRule()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			files := map[string]string{"main.tf": test.content}
+			if test.config != "" {
+				files[".tflint.hcl"] = test.config
+			}
+			runner := helper.TestRunner(t, files)
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.expected, runner.Issues)
+		})
+	}
+}
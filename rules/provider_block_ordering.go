@@ -0,0 +1,104 @@
+package rules
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// ProviderBlockOrderingRule checks that default provider blocks appear before their aliased blocks
+type ProviderBlockOrderingRule struct {
+	tflint.DefaultRule
+	docsLink
+}
+
+// NewProviderBlockOrderingRule creates a new rule instance
+func NewProviderBlockOrderingRule() *ProviderBlockOrderingRule {
+	return &ProviderBlockOrderingRule{}
+}
+
+// Name returns the rule name
+func (r *ProviderBlockOrderingRule) Name() string {
+	return "provider_block_ordering"
+}
+
+// Enabled returns whether the rule is enabled
+func (r *ProviderBlockOrderingRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *ProviderBlockOrderingRule) Severity() tflint.Severity {
+	return tflint.NOTICE
+}
+
+// Link returns a link to detailed information about the rule
+func (r *ProviderBlockOrderingRule) Link() string {
+	return r.link(r.Name())
+}
+
+// Check executes the rule checking process
+func (r *ProviderBlockOrderingRule) Check(runner tflint.Runner) error {
+	files, fileNames, err := sortedFiles(runner)
+	if err != nil {
+		return err
+	}
+
+	for _, fileName := range fileNames {
+		body, ok := files[fileName].Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		var providers []*hclsyntax.Block
+		for _, block := range body.Blocks {
+			if block.Type == "provider" && len(block.Labels) > 0 {
+				providers = append(providers, block)
+			}
+		}
+
+		// Sort blocks by position (by line number)
+		sort.Slice(providers, func(i, j int) bool {
+			return providers[i].Range().Start.Line < providers[j].Range().Start.Line
+		})
+
+		// Find the line of the default (unaliased) block of each provider
+		defaultLines := make(map[string]int)
+		for _, block := range providers {
+			if _, aliased := block.Body.Attributes["alias"]; aliased {
+				continue
+			}
+			if _, exists := defaultLines[block.Labels[0]]; !exists {
+				defaultLines[block.Labels[0]] = block.Range().Start.Line
+			}
+		}
+
+		for _, block := range providers {
+			aliasAttr, aliased := block.Body.Attributes["alias"]
+			if !aliased {
+				continue
+			}
+			defaultLine, exists := defaultLines[block.Labels[0]]
+			if !exists || block.Range().Start.Line > defaultLine {
+				continue
+			}
+			alias, ok := literalString(aliasAttr.Expr)
+			if !ok {
+				continue
+			}
+
+			err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("Aliased provider \"%s.%s\" appears before the default provider", block.Labels[0], alias),
+				block.DefRange(),
+			)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
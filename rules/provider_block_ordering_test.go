@@ -0,0 +1,79 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestProviderBlockOrderingRule(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected helper.Issues
+	}{
+		{
+			name: "default provider first",
+			content: `
+provider "aws" {
+  region = "us-east-1"
+}
+
+provider "aws" {
+  alias  = "secondary"
+  region = "us-west-2"
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "aliased provider first",
+			content: `
+provider "aws" {
+  alias  = "secondary"
+  region = "us-west-2"
+}
+
+provider "aws" {
+  region = "us-east-1"
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewProviderBlockOrderingRule(),
+					Message: "Aliased provider \"aws.secondary\" appears before the default provider",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 15},
+					},
+				},
+			},
+		},
+		{
+			name: "aliased provider without default",
+			content: `
+provider "aws" {
+  alias  = "secondary"
+  region = "us-west-2"
+}
+
+provider "google" {
+  project = "example"
+}`,
+			expected: helper.Issues{},
+		},
+	}
+
+	rule := NewProviderBlockOrderingRule()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": test.content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// ProviderRegionLiteralRule checks that provider regions are not hardcoded
+type ProviderRegionLiteralRule struct {
+	tflint.DefaultRule
+	docsLink
+}
+
+// providerRegionLiteralRuleConfig is the configuration for the rule
+type providerRegionLiteralRuleConfig struct {
+	Allow []string `hclext:"allow,optional"`
+}
+
+// NewProviderRegionLiteralRule creates a new rule instance
+func NewProviderRegionLiteralRule() *ProviderRegionLiteralRule {
+	return &ProviderRegionLiteralRule{}
+}
+
+// Name returns the rule name
+func (r *ProviderRegionLiteralRule) Name() string {
+	return "provider_region_literal"
+}
+
+// Enabled returns whether the rule is enabled
+func (r *ProviderRegionLiteralRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *ProviderRegionLiteralRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns a link to detailed information about the rule
+func (r *ProviderRegionLiteralRule) Link() string {
+	return r.link(r.Name())
+}
+
+// Check executes the rule checking process
+func (r *ProviderRegionLiteralRule) Check(runner tflint.Runner) error {
+	// Decode rule configuration
+	config := providerRegionLiteralRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	allowed := make(map[string]bool)
+	for _, region := range config.Allow {
+		allowed[region] = true
+	}
+
+	files, fileNames, err := sortedFiles(runner)
+	if err != nil {
+		return err
+	}
+
+	for _, fileName := range fileNames {
+		body, ok := files[fileName].Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		for _, block := range body.Blocks {
+			if block.Type != "provider" || len(block.Labels) == 0 {
+				continue
+			}
+
+			regionAttr, exists := block.Body.Attributes["region"]
+			if !exists {
+				continue
+			}
+			region, ok := literalString(regionAttr.Expr)
+			if !ok || allowed[region] {
+				continue
+			}
+
+			err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("Provider \"%s\" region is hardcoded to \"%s\"; use a variable", block.Labels[0], region),
+				regionAttr.Range(),
+			)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
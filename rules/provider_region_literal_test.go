@@ -0,0 +1,85 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestProviderRegionLiteralRule(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		config   string
+		expected helper.Issues
+	}{
+		{
+			name: "literal region",
+			content: `
+provider "aws" {
+  region = "us-east-1"
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewProviderRegionLiteralRule(),
+					Message: "Provider \"aws\" region is hardcoded to \"us-east-1\"; use a variable",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 23},
+					},
+				},
+			},
+		},
+		{
+			name: "variable region",
+			content: `
+provider "aws" {
+  region = var.region
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "data source region",
+			content: `
+data "aws_region" "current" {}
+
+provider "aws" {
+  alias  = "current"
+  region = data.aws_region.current.name
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "allowed region",
+			content: `
+provider "aws" {
+  region = "us-east-1"
+}`,
+			config: `
+rule "provider_region_literal" {
+  enabled = true
+  allow   = ["us-east-1"]
+}`,
+			expected: helper.Issues{},
+		},
+	}
+
+	rule := NewProviderRegionLiteralRule()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			files := map[string]string{"main.tf": test.content}
+			if test.config != "" {
+				files[".tflint.hcl"] = test.config
+			}
+			runner := helper.TestRunner(t, files)
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.expected, runner.Issues)
+		})
+	}
+}
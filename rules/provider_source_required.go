@@ -0,0 +1,107 @@
+package rules
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// ProviderSourceRequiredRule checks that every required_providers entry declares a source
+type ProviderSourceRequiredRule struct {
+	tflint.DefaultRule
+	docsLink
+}
+
+// NewProviderSourceRequiredRule creates a new rule instance
+func NewProviderSourceRequiredRule() *ProviderSourceRequiredRule {
+	return &ProviderSourceRequiredRule{}
+}
+
+// Name returns the rule name
+func (r *ProviderSourceRequiredRule) Name() string {
+	return "provider_source_required"
+}
+
+// Enabled returns whether the rule is enabled
+func (r *ProviderSourceRequiredRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *ProviderSourceRequiredRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns a link to detailed information about the rule
+func (r *ProviderSourceRequiredRule) Link() string {
+	return r.link(r.Name())
+}
+
+// Check executes the rule checking process
+func (r *ProviderSourceRequiredRule) Check(runner tflint.Runner) error {
+	files, fileNames, err := sortedFiles(runner)
+	if err != nil {
+		return err
+	}
+
+	for _, fileName := range fileNames {
+		body, ok := files[fileName].Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		for _, block := range body.Blocks {
+			if block.Type != "terraform" {
+				continue
+			}
+			for _, inner := range block.Body.Blocks {
+				if inner.Type != "required_providers" {
+					continue
+				}
+
+				// Sort attributes by position (by line number)
+				var attrs []*hclsyntax.Attribute
+				for _, attr := range inner.Body.Attributes {
+					attrs = append(attrs, attr)
+				}
+				sort.Slice(attrs, func(i, j int) bool {
+					return attrs[i].Range().Start.Line < attrs[j].Range().Start.Line
+				})
+
+				for _, attr := range attrs {
+					if r.hasSource(attr.Expr) {
+						continue
+					}
+
+					err := runner.EmitIssue(
+						r,
+						fmt.Sprintf("Provider \"%s\" in required_providers is missing a source", attr.Name),
+						attr.Range(),
+					)
+					if err != nil {
+						return err
+					}
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// hasSource returns whether a required_providers entry declares a source
+// The legacy string form (aws = "~> 5.0") has no source, while entries built dynamically are assumed to have one
+func (r *ProviderSourceRequiredRule) hasSource(expr hclsyntax.Expression) bool {
+	if _, ok := literalString(expr); ok {
+		return false
+	}
+
+	obj, ok := expr.(*hclsyntax.ObjectConsExpr)
+	if !ok {
+		return true
+	}
+	_, exists := objectItem(obj, "source")
+	return exists
+}
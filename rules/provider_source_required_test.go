@@ -0,0 +1,75 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestProviderSourceRequiredRule(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected helper.Issues
+	}{
+		{
+			name: "complete entries",
+			content: `
+terraform {
+  required_providers {
+    aws = {
+      source  = "hashicorp/aws"
+      version = "~> 5.0"
+    }
+  }
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "source-less entries",
+			content: `
+terraform {
+  required_providers {
+    aws = {
+      version = "~> 5.0"
+    }
+    random = "~> 3.0"
+  }
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewProviderSourceRequiredRule(),
+					Message: "Provider \"aws\" in required_providers is missing a source",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 4, Column: 5},
+						End:      hcl.Pos{Line: 6, Column: 6},
+					},
+				},
+				{
+					Rule:    NewProviderSourceRequiredRule(),
+					Message: "Provider \"random\" in required_providers is missing a source",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 7, Column: 5},
+						End:      hcl.Pos{Line: 7, Column: 22},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewProviderSourceRequiredRule()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": test.content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,149 @@
+package rules
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// ProviderVersionConstraintRule checks that provider version constraints have an upper bound
+type ProviderVersionConstraintRule struct {
+	tflint.DefaultRule
+	docsLink
+}
+
+// providerVersionConstraintRuleConfig is the configuration for the rule
+type providerVersionConstraintRuleConfig struct {
+	RequirePessimistic bool `hclext:"require_pessimistic,optional"`
+}
+
+// NewProviderVersionConstraintRule creates a new rule instance
+func NewProviderVersionConstraintRule() *ProviderVersionConstraintRule {
+	return &ProviderVersionConstraintRule{}
+}
+
+// Name returns the rule name
+func (r *ProviderVersionConstraintRule) Name() string {
+	return "provider_version_constraint"
+}
+
+// Enabled returns whether the rule is enabled
+func (r *ProviderVersionConstraintRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *ProviderVersionConstraintRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns a link to detailed information about the rule
+func (r *ProviderVersionConstraintRule) Link() string {
+	return r.link(r.Name())
+}
+
+// Check executes the rule checking process
+func (r *ProviderVersionConstraintRule) Check(runner tflint.Runner) error {
+	// Decode rule configuration
+	config := providerVersionConstraintRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	files, fileNames, err := sortedFiles(runner)
+	if err != nil {
+		return err
+	}
+
+	for _, fileName := range fileNames {
+		body, ok := files[fileName].Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		for _, block := range body.Blocks {
+			if block.Type != "terraform" {
+				continue
+			}
+			for _, inner := range block.Body.Blocks {
+				if inner.Type != "required_providers" {
+					continue
+				}
+
+				// Sort attributes by position (by line number)
+				var attrs []*hclsyntax.Attribute
+				for _, attr := range inner.Body.Attributes {
+					attrs = append(attrs, attr)
+				}
+				sort.Slice(attrs, func(i, j int) bool {
+					return attrs[i].Range().Start.Line < attrs[j].Range().Start.Line
+				})
+
+				for _, attr := range attrs {
+					constraint, rng, ok := r.versionConstraint(attr.Expr)
+					if !ok {
+						continue
+					}
+
+					var message string
+					switch {
+					case config.RequirePessimistic && !strings.Contains(constraint, "~>"):
+						message = fmt.Sprintf("Provider \"%s\" version constraint \"%s\" must use ~>", attr.Name, constraint)
+					case !r.hasUpperBound(constraint):
+						message = fmt.Sprintf("Provider \"%s\" version constraint \"%s\" is too loose; use ~> or an upper bound", attr.Name, constraint)
+					default:
+						continue
+					}
+
+					if err := runner.EmitIssue(r, message, rng); err != nil {
+						return err
+					}
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// versionConstraint returns the version constraint of a required_providers entry and its range
+// Both the object form ({ source = ..., version = ... }) and the legacy string form are supported
+func (r *ProviderVersionConstraintRule) versionConstraint(expr hcl.Expression) (string, hcl.Range, bool) {
+	if version, ok := literalString(expr); ok {
+		return version, expr.Range(), true
+	}
+
+	obj, ok := expr.(*hclsyntax.ObjectConsExpr)
+	if !ok {
+		return "", hcl.Range{}, false
+	}
+	versionExpr, exists := objectItem(obj, "version")
+	if !exists {
+		return "", hcl.Range{}, false
+	}
+	if version, ok := literalString(versionExpr); ok {
+		return version, versionExpr.Range(), true
+	}
+	return "", hcl.Range{}, false
+}
+
+// hasUpperBound returns whether any clause of a constraint limits the maximum version
+// ~>, <, <= and exact versions (= or no operator) are bounded, while >, >= and != are not
+func (r *ProviderVersionConstraintRule) hasUpperBound(constraint string) bool {
+	for _, clause := range strings.Split(constraint, ",") {
+		clause = strings.TrimSpace(clause)
+		switch {
+		case clause == "":
+			continue
+		case strings.HasPrefix(clause, ">"), strings.HasPrefix(clause, "!="):
+			continue
+		default:
+			return true
+		}
+	}
+	return false
+}
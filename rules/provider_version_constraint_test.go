@@ -0,0 +1,153 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestProviderVersionConstraintRule(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		config   string
+		expected helper.Issues
+	}{
+		{
+			name: "loose constraint",
+			content: `
+terraform {
+  required_providers {
+    aws = {
+      source  = "hashicorp/aws"
+      version = ">= 4.0"
+    }
+  }
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewProviderVersionConstraintRule(),
+					Message: "Provider \"aws\" version constraint \">= 4.0\" is too loose; use ~> or an upper bound",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 6, Column: 17},
+						End:      hcl.Pos{Line: 6, Column: 25},
+					},
+				},
+			},
+		},
+		{
+			name: "loose constraint with quoted key",
+			content: `
+terraform {
+  required_providers {
+    aws = {
+      "source"  = "hashicorp/aws"
+      "version" = ">= 4.0"
+    }
+  }
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewProviderVersionConstraintRule(),
+					Message: "Provider \"aws\" version constraint \">= 4.0\" is too loose; use ~> or an upper bound",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 6, Column: 19},
+						End:      hcl.Pos{Line: 6, Column: 27},
+					},
+				},
+			},
+		},
+		{
+			name: "lower and upper bound",
+			content: `
+terraform {
+  required_providers {
+    aws = {
+      source  = "hashicorp/aws"
+      version = ">= 4.0, < 6.0"
+    }
+  }
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "pessimistic constraint",
+			content: `
+terraform {
+  required_providers {
+    aws = {
+      source  = "hashicorp/aws"
+      version = "~> 5.0"
+    }
+  }
+}`,
+			config: `
+rule "provider_version_constraint" {
+  enabled = true
+  require_pessimistic = true
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "exact constraint",
+			content: `
+terraform {
+  required_providers {
+    aws = {
+      source  = "hashicorp/aws"
+      version = "5.0.0"
+    }
+  }
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "exact constraint with require_pessimistic",
+			content: `
+terraform {
+  required_providers {
+    aws = {
+      source  = "hashicorp/aws"
+      version = "5.0.0"
+    }
+  }
+}`,
+			config: `
+rule "provider_version_constraint" {
+  enabled = true
+  require_pessimistic = true
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewProviderVersionConstraintRule(),
+					Message: "Provider \"aws\" version constraint \"5.0.0\" must use ~>",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 6, Column: 17},
+						End:      hcl.Pos{Line: 6, Column: 24},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewProviderVersionConstraintRule()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			files := map[string]string{"main.tf": test.content}
+			if test.config != "" {
+				files[".tflint.hcl"] = test.config
+			}
+			runner := helper.TestRunner(t, files)
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,110 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// lookupAttributes are the attributes used to match a data source to a managed resource
+var lookupAttributes = []string{"bucket", "name"}
+
+// RedundantDataSourceRule checks for data sources that look up resources managed in the same configuration
+type RedundantDataSourceRule struct {
+	tflint.DefaultRule
+	docsLink
+}
+
+// NewRedundantDataSourceRule creates a new rule instance
+func NewRedundantDataSourceRule() *RedundantDataSourceRule {
+	return &RedundantDataSourceRule{}
+}
+
+// Name returns the rule name
+func (r *RedundantDataSourceRule) Name() string {
+	return "redundant_data_source"
+}
+
+// Enabled returns whether the rule is enabled
+func (r *RedundantDataSourceRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *RedundantDataSourceRule) Severity() tflint.Severity {
+	return tflint.NOTICE
+}
+
+// Link returns a link to detailed information about the rule
+func (r *RedundantDataSourceRule) Link() string {
+	return r.link(r.Name())
+}
+
+// Check executes the rule checking process
+func (r *RedundantDataSourceRule) Check(runner tflint.Runner) error {
+	files, fileNames, err := sortedFiles(runner)
+	if err != nil {
+		return err
+	}
+
+	// Collect lookup keys of managed resources
+	managed := make(map[string]bool)
+	for _, fileName := range fileNames {
+		body, ok := files[fileName].Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		for _, block := range body.Blocks {
+			if block.Type != "resource" || len(block.Labels) < 2 {
+				continue
+			}
+			if key, ok := r.lookupKey(block); ok {
+				managed[key] = true
+			}
+		}
+	}
+
+	for _, fileName := range fileNames {
+		body, ok := files[fileName].Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		for _, block := range body.Blocks {
+			if block.Type != "data" || len(block.Labels) < 2 {
+				continue
+			}
+			key, ok := r.lookupKey(block)
+			if !ok || !managed[key] {
+				continue
+			}
+
+			err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("Data source \"%s\" may duplicate managed resource; reference the resource directly", block.Labels[1]),
+				block.DefRange(),
+			)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// lookupKey returns a key made of the block type and its literal bucket or name attribute
+func (r *RedundantDataSourceRule) lookupKey(block *hclsyntax.Block) (string, bool) {
+	for _, name := range lookupAttributes {
+		attr, exists := block.Body.Attributes[name]
+		if !exists {
+			continue
+		}
+		if value, ok := literalString(attr.Expr); ok {
+			return block.Labels[0] + "/" + name + "=" + value, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,64 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestRedundantDataSourceRule(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected helper.Issues
+	}{
+		{
+			name: "data source matching a managed resource",
+			content: `
+resource "aws_s3_bucket" "logs" {
+  bucket = "example-logs"
+}
+
+data "aws_s3_bucket" "logs" {
+  bucket = "example-logs"
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewRedundantDataSourceRule(),
+					Message: "Data source \"logs\" may duplicate managed resource; reference the resource directly",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 6, Column: 1},
+						End:      hcl.Pos{Line: 6, Column: 28},
+					},
+				},
+			},
+		},
+		{
+			name: "data source not matching a managed resource",
+			content: `
+resource "aws_s3_bucket" "logs" {
+  bucket = "example-logs"
+}
+
+data "aws_s3_bucket" "shared" {
+  bucket = "shared-artifacts"
+}`,
+			expected: helper.Issues{},
+		},
+	}
+
+	rule := NewRedundantDataSourceRule()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": test.content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.expected, runner.Issues)
+		})
+	}
+}
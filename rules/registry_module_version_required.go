@@ -0,0 +1,89 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// RegistryModuleVersionRequiredRule checks that registry modules set a version
+type RegistryModuleVersionRequiredRule struct {
+	tflint.DefaultRule
+	docsLink
+}
+
+// NewRegistryModuleVersionRequiredRule creates a new rule instance
+func NewRegistryModuleVersionRequiredRule() *RegistryModuleVersionRequiredRule {
+	return &RegistryModuleVersionRequiredRule{}
+}
+
+// Name returns the rule name
+func (r *RegistryModuleVersionRequiredRule) Name() string {
+	return "registry_module_version_required"
+}
+
+// Enabled returns whether the rule is enabled
+func (r *RegistryModuleVersionRequiredRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *RegistryModuleVersionRequiredRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns a link to detailed information about the rule
+func (r *RegistryModuleVersionRequiredRule) Link() string {
+	return r.link(r.Name())
+}
+
+// Check executes the rule checking process
+func (r *RegistryModuleVersionRequiredRule) Check(runner tflint.Runner) error {
+	files, fileNames, err := sortedFiles(runner)
+	if err != nil {
+		return err
+	}
+
+	for _, fileName := range fileNames {
+		body, ok := files[fileName].Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		for _, block := range body.Blocks {
+			if block.Type != "module" || len(block.Labels) == 0 {
+				continue
+			}
+
+			sourceAttr, exists := block.Body.Attributes["source"]
+			if !exists {
+				continue
+			}
+			source, ok := literalString(sourceAttr.Expr)
+			if !ok || !isRegistrySource(source) {
+				continue
+			}
+
+			if versionAttr, exists := block.Body.Attributes["version"]; exists {
+				// Non-literal versions (e.g. variables) are assumed to be set
+				version, ok := literalString(versionAttr.Expr)
+				if !ok || strings.TrimSpace(version) != "" {
+					continue
+				}
+			}
+
+			err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("Registry module \"%s\" must set version", block.Labels[0]),
+				block.DefRange(),
+			)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
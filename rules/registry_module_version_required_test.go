@@ -0,0 +1,87 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestRegistryModuleVersionRequiredRule(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected helper.Issues
+	}{
+		{
+			name: "registry module with version",
+			content: `
+module "vpc" {
+  source  = "terraform-aws-modules/vpc/aws"
+  version = "~> 5.1"
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "registry module without version",
+			content: `
+module "vpc" {
+  source = "terraform-aws-modules/vpc/aws"
+}
+
+module "private" {
+  source  = "app.terraform.io/example-corp/k8s-cluster/azurerm"
+  version = ""
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewRegistryModuleVersionRequiredRule(),
+					Message: "Registry module \"vpc\" must set version",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 13},
+					},
+				},
+				{
+					Rule:    NewRegistryModuleVersionRequiredRule(),
+					Message: "Registry module \"private\" must set version",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 6, Column: 1},
+						End:      hcl.Pos{Line: 6, Column: 17},
+					},
+				},
+			},
+		},
+		{
+			name: "git and local sources",
+			content: `
+module "git" {
+  source = "git::https://example.com/vpc.git"
+}
+
+module "github" {
+  source = "github.com/hashicorp/example"
+}
+
+module "local" {
+  source = "./modules/vpc"
+}`,
+			expected: helper.Issues{},
+		},
+	}
+
+	rule := NewRegistryModuleVersionRequiredRule()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": test.content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// builtinProviders are providers that ship with Terraform and need no declaration
+var builtinProviders = map[string]bool{
+	"terraform": true,
+}
+
+// RequiredProvidersDeclaredRule checks that every provider used by resources and data sources is declared in required_providers
+type RequiredProvidersDeclaredRule struct {
+	tflint.DefaultRule
+	docsLink
+}
+
+// NewRequiredProvidersDeclaredRule creates a new rule instance
+func NewRequiredProvidersDeclaredRule() *RequiredProvidersDeclaredRule {
+	return &RequiredProvidersDeclaredRule{}
+}
+
+// Name returns the rule name
+func (r *RequiredProvidersDeclaredRule) Name() string {
+	return "required_providers_declared"
+}
+
+// Enabled returns whether the rule is enabled
+func (r *RequiredProvidersDeclaredRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *RequiredProvidersDeclaredRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns a link to detailed information about the rule
+func (r *RequiredProvidersDeclaredRule) Link() string {
+	return r.link(r.Name())
+}
+
+// Check executes the rule checking process
+func (r *RequiredProvidersDeclaredRule) Check(runner tflint.Runner) error {
+	files, fileNames, err := sortedFiles(runner)
+	if err != nil {
+		return err
+	}
+
+	// Collect providers declared in terraform.required_providers
+	declared := make(map[string]bool)
+	for _, fileName := range fileNames {
+		body, ok := files[fileName].Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		for _, block := range body.Blocks {
+			if block.Type != "terraform" {
+				continue
+			}
+			for _, inner := range block.Body.Blocks {
+				if inner.Type != "required_providers" {
+					continue
+				}
+				for name := range inner.Body.Attributes {
+					declared[name] = true
+				}
+			}
+		}
+	}
+
+	// Report each undeclared provider once, at its first use
+	reported := make(map[string]bool)
+	for _, fileName := range fileNames {
+		body, ok := files[fileName].Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		for _, block := range body.Blocks {
+			if (block.Type != "resource" && block.Type != "data") || len(block.Labels) == 0 {
+				continue
+			}
+
+			provider := strings.SplitN(block.Labels[0], "_", 2)[0]
+			if declared[provider] || builtinProviders[provider] || reported[provider] {
+				continue
+			}
+			reported[provider] = true
+
+			err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("Provider \"%s\" is used but not declared in required_providers", provider),
+				block.LabelRanges[0],
+			)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
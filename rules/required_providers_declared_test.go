@@ -0,0 +1,98 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestRequiredProvidersDeclaredRule(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected helper.Issues
+	}{
+		{
+			name: "declared provider",
+			content: `
+terraform {
+  required_providers {
+    aws = {
+      source = "hashicorp/aws"
+    }
+  }
+}
+
+resource "aws_s3_bucket" "this" {
+  bucket = "example"
+}
+
+data "aws_caller_identity" "current" {}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "undeclared provider",
+			content: `
+terraform {
+  required_providers {
+    aws = {
+      source = "hashicorp/aws"
+    }
+  }
+}
+
+resource "aws_s3_bucket" "this" {
+  bucket = "example"
+}
+
+data "google_project" "this" {}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewRequiredProvidersDeclaredRule(),
+					Message: "Provider \"google\" is used but not declared in required_providers",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 14, Column: 6},
+						End:      hcl.Pos{Line: 14, Column: 22},
+					},
+				},
+			},
+		},
+		{
+			name: "no terraform block",
+			content: `
+resource "aws_s3_bucket" "a" {
+  bucket = "a"
+}
+
+resource "aws_s3_bucket" "b" {
+  bucket = "b"
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewRequiredProvidersDeclaredRule(),
+					Message: "Provider \"aws\" is used but not declared in required_providers",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 10},
+						End:      hcl.Pos{Line: 2, Column: 25},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewRequiredProvidersDeclaredRule()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": test.content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package rules
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// RequiredTerraformVersionRule checks that a terraform required_version constraint is set
+type RequiredTerraformVersionRule struct {
+	tflint.DefaultRule
+	docsLink
+}
+
+// requiredTerraformVersionRuleConfig is the configuration for the rule
+type requiredTerraformVersionRuleConfig struct {
+	Pattern string `hclext:"pattern,optional"`
+}
+
+// NewRequiredTerraformVersionRule creates a new rule instance
+func NewRequiredTerraformVersionRule() *RequiredTerraformVersionRule {
+	return &RequiredTerraformVersionRule{}
+}
+
+// Name returns the rule name
+func (r *RequiredTerraformVersionRule) Name() string {
+	return "required_terraform_version"
+}
+
+// Enabled returns whether the rule is enabled
+func (r *RequiredTerraformVersionRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *RequiredTerraformVersionRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns a link to detailed information about the rule
+func (r *RequiredTerraformVersionRule) Link() string {
+	return r.link(r.Name())
+}
+
+// Check executes the rule checking process
+func (r *RequiredTerraformVersionRule) Check(runner tflint.Runner) error {
+	// Decode rule configuration
+	config := requiredTerraformVersionRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	var pattern *regexp.Regexp
+	if config.Pattern != "" {
+		var err error
+		pattern, err = regexp.Compile(config.Pattern)
+		if err != nil {
+			return fmt.Errorf("invalid pattern %q: %w", config.Pattern, err)
+		}
+	}
+
+	files, fileNames, err := sortedFiles(runner)
+	if err != nil {
+		return err
+	}
+	if len(fileNames) == 0 {
+		return nil
+	}
+
+	// Point at the first terraform block, or the top of the first file when there is none
+	missingRange := hcl.Range{Filename: fileNames[0], Start: hcl.InitialPos, End: hcl.InitialPos}
+	foundBlock := false
+	var versionAttrs []*hclsyntax.Attribute
+
+	for _, fileName := range fileNames {
+		body, ok := files[fileName].Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		for _, block := range body.Blocks {
+			if block.Type != "terraform" {
+				continue
+			}
+			if !foundBlock {
+				foundBlock = true
+				missingRange = block.DefRange()
+			}
+			if attr, exists := block.Body.Attributes["required_version"]; exists {
+				versionAttrs = append(versionAttrs, attr)
+			}
+		}
+	}
+
+	if len(versionAttrs) == 0 {
+		return runner.EmitIssue(
+			r,
+			"No terraform { required_version } constraint found",
+			missingRange,
+		)
+	}
+
+	if pattern == nil {
+		return nil
+	}
+
+	for _, attr := range versionAttrs {
+		constraint, ok := literalString(attr.Expr)
+		if !ok || pattern.MatchString(constraint) {
+			continue
+		}
+
+		err := runner.EmitIssue(
+			r,
+			fmt.Sprintf("Terraform required_version \"%s\" does not match /%s/", constraint, config.Pattern),
+			attr.Range(),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,115 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestRequiredTerraformVersionRule(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		config   string
+		expected helper.Issues
+	}{
+		{
+			name: "required_version present",
+			content: `
+terraform {
+  required_version = "~> 1.5"
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "terraform block without required_version",
+			content: `
+terraform {
+  backend "s3" {}
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewRequiredTerraformVersionRule(),
+					Message: "No terraform { required_version } constraint found",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 10},
+					},
+				},
+			},
+		},
+		{
+			name: "no terraform block",
+			content: `
+module "module_a" {
+  source = "./modules/a"
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewRequiredTerraformVersionRule(),
+					Message: "No terraform { required_version } constraint found",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 1, Column: 1},
+						End:      hcl.Pos{Line: 1, Column: 1},
+					},
+				},
+			},
+		},
+		{
+			name: "pattern match",
+			content: `
+terraform {
+  required_version = "~> 1.5"
+}`,
+			config: `
+rule "required_terraform_version" {
+  enabled = true
+  pattern = "^~> 1\\."
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "pattern mismatch",
+			content: `
+terraform {
+  required_version = ">= 0.14"
+}`,
+			config: `
+rule "required_terraform_version" {
+  enabled = true
+  pattern = "^~> 1\\."
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewRequiredTerraformVersionRule(),
+					Message: "Terraform required_version \">= 0.14\" does not match /^~> 1\\./",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 31},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewRequiredTerraformVersionRule()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			files := map[string]string{"main.tf": test.content}
+			if test.config != "" {
+				files[".tflint.hcl"] = test.config
+			}
+			runner := helper.TestRunner(t, files)
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// ResourceExplicitProviderRule checks that resources set provider when their provider has several configurations
+type ResourceExplicitProviderRule struct {
+	tflint.DefaultRule
+	docsLink
+}
+
+// NewResourceExplicitProviderRule creates a new rule instance
+func NewResourceExplicitProviderRule() *ResourceExplicitProviderRule {
+	return &ResourceExplicitProviderRule{}
+}
+
+// Name returns the rule name
+func (r *ResourceExplicitProviderRule) Name() string {
+	return "resource_explicit_provider"
+}
+
+// Enabled returns whether the rule is enabled
+func (r *ResourceExplicitProviderRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *ResourceExplicitProviderRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns a link to detailed information about the rule
+func (r *ResourceExplicitProviderRule) Link() string {
+	return r.link(r.Name())
+}
+
+// Check executes the rule checking process
+func (r *ResourceExplicitProviderRule) Check(runner tflint.Runner) error {
+	files, fileNames, err := sortedFiles(runner)
+	if err != nil {
+		return err
+	}
+
+	// Count the aliased configurations of each provider
+	// The default (unaliased) block is not counted, since resources without a provider resolve to it
+	aliases := make(map[string]int)
+	for _, fileName := range fileNames {
+		body, ok := files[fileName].Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+		for _, block := range body.Blocks {
+			if block.Type != "provider" || len(block.Labels) == 0 {
+				continue
+			}
+			if _, exists := block.Body.Attributes["alias"]; exists {
+				aliases[block.Labels[0]]++
+			}
+		}
+	}
+
+	for _, fileName := range fileNames {
+		body, ok := files[fileName].Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		for _, block := range body.Blocks {
+			if block.Type != "resource" || len(block.Labels) < 2 {
+				continue
+			}
+
+			provider := strings.SplitN(block.Labels[0], "_", 2)[0]
+			if aliases[provider] < 2 {
+				continue
+			}
+			if _, exists := block.Body.Attributes["provider"]; exists {
+				continue
+			}
+
+			err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("Resource \"%s.%s\" must specify an explicit provider", block.Labels[0], block.Labels[1]),
+				block.DefRange(),
+			)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,108 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestResourceExplicitProviderRule(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected helper.Issues
+	}{
+		{
+			name: "single provider",
+			content: `
+provider "aws" {
+  region = "us-east-1"
+}
+
+resource "aws_instance" "web" {
+  ami = "ami-123456"
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "default provider with a single alias",
+			content: `
+provider "aws" {
+  region = "us-east-1"
+}
+
+provider "aws" {
+  alias  = "west"
+  region = "us-west-2"
+}
+
+resource "aws_instance" "web" {
+  ami = "ami-123456"
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "multiple providers",
+			content: `
+provider "aws" {
+  alias  = "east"
+  region = "us-east-1"
+}
+
+provider "aws" {
+  alias  = "west"
+  region = "us-west-2"
+}
+
+resource "aws_instance" "web" {
+  ami = "ami-123456"
+}
+
+resource "aws_instance" "api" {
+  provider = aws.west
+  ami      = "ami-123456"
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewResourceExplicitProviderRule(),
+					Message: "Resource \"aws_instance.web\" must specify an explicit provider",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 12, Column: 1},
+						End:      hcl.Pos{Line: 12, Column: 30},
+					},
+				},
+			},
+		},
+		{
+			name: "other provider with multiple configurations",
+			content: `
+provider "google" {
+  alias = "a"
+}
+
+provider "google" {
+  alias = "b"
+}
+
+resource "aws_instance" "web" {
+  ami = "ami-123456"
+}`,
+			expected: helper.Issues{},
+		},
+	}
+
+	rule := NewResourceExplicitProviderRule()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": test.content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.expected, runner.Issues)
+		})
+	}
+}
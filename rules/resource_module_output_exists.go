@@ -0,0 +1,96 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// ResourceModuleOutputExistsRule checks that module output references outside module blocks point to declared outputs
+type ResourceModuleOutputExistsRule struct {
+	tflint.DefaultRule
+	docsLink
+}
+
+// NewResourceModuleOutputExistsRule creates a new rule instance
+func NewResourceModuleOutputExistsRule() *ResourceModuleOutputExistsRule {
+	return &ResourceModuleOutputExistsRule{}
+}
+
+// Name returns the rule name
+func (r *ResourceModuleOutputExistsRule) Name() string {
+	return "resource_module_output_exists"
+}
+
+// Enabled returns whether the rule is enabled
+func (r *ResourceModuleOutputExistsRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *ResourceModuleOutputExistsRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns a link to detailed information about the rule
+func (r *ResourceModuleOutputExistsRule) Link() string {
+	return r.link(r.Name())
+}
+
+// Check executes the rule checking process
+func (r *ResourceModuleOutputExistsRule) Check(runner tflint.Runner) error {
+	files, fileNames, err := sortedFiles(runner)
+	if err != nil {
+		return err
+	}
+
+	// Resolve the outputs declared by each local module
+	outputs, err := localModuleOutputs(files, fileNames)
+	if err != nil {
+		return err
+	}
+
+	for _, fileName := range fileNames {
+		body, ok := files[fileName].Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		for _, block := range body.Blocks {
+			// References in module arguments are checked by module_unknown_output
+			if block.Type == "module" {
+				continue
+			}
+
+			var emitErr error
+			walkAttributes(block.Body, func(attr *hclsyntax.Attribute) {
+				for _, traversal := range collectTraversals(attr.Expr, "module") {
+					if emitErr != nil {
+						return
+					}
+
+					moduleName, output, ok := moduleOutputFromTraversal(traversal)
+					if !ok {
+						continue
+					}
+					declared, exists := outputs[moduleName]
+					if !exists || declared[output] {
+						continue
+					}
+
+					emitErr = runner.EmitIssue(
+						r,
+						fmt.Sprintf("Resource references undeclared output \"%s\" of module \"%s\"", output, moduleName),
+						traversal.SourceRange(),
+					)
+				}
+			})
+			if emitErr != nil {
+				return emitErr
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,104 @@
+package rules
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestResourceModuleOutputExistsRule(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "modules", "network"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "modules", "network", "outputs.tf"), []byte(`
+output "subnet_id" {
+  value = "subnet-123"
+}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "modules", "network", "outputs.tf.json"), []byte(`{
+  "output": {
+    "vpc_id": {
+      "value": "vpc-123"
+    }
+  }
+}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	mainFile := filepath.Join(dir, "main.tf")
+
+	tests := []struct {
+		name     string
+		content  string
+		expected helper.Issues
+	}{
+		{
+			name: "valid output reference",
+			content: `
+module "network" {
+  source = "./modules/network"
+}
+
+resource "aws_instance" "web" {
+  subnet_id = module.network.subnet_id
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "output declared in a JSON file",
+			content: `
+module "network" {
+  source = "./modules/network"
+}
+
+resource "aws_security_group" "web" {
+  vpc_id = module.network.vpc_id
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "invalid output reference",
+			content: `
+module "network" {
+  source = "./modules/network"
+}
+
+resource "aws_instance" "web" {
+  subnet_id = module.network.private_subnet_id
+}
+
+output "subnet" {
+  value = module.network.subnet_id
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewResourceModuleOutputExistsRule(),
+					Message: "Resource references undeclared output \"private_subnet_id\" of module \"network\"",
+					Range: hcl.Range{
+						Filename: mainFile,
+						Start:    hcl.Pos{Line: 7, Column: 15},
+						End:      hcl.Pos{Line: 7, Column: 47},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewResourceModuleOutputExistsRule()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{mainFile: test.content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// ResourceNamePrefixRule checks that resource names start with the prefix configured for their type
+type ResourceNamePrefixRule struct {
+	tflint.DefaultRule
+	docsLink
+}
+
+// resourceNamePrefixRuleConfig is the configuration for the rule
+type resourceNamePrefixRuleConfig struct {
+	Prefixes map[string]string `hclext:"prefixes,optional"`
+}
+
+// NewResourceNamePrefixRule creates a new rule instance
+func NewResourceNamePrefixRule() *ResourceNamePrefixRule {
+	return &ResourceNamePrefixRule{}
+}
+
+// Name returns the rule name
+func (r *ResourceNamePrefixRule) Name() string {
+	return "resource_name_prefix"
+}
+
+// Enabled returns whether the rule is enabled
+func (r *ResourceNamePrefixRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *ResourceNamePrefixRule) Severity() tflint.Severity {
+	return tflint.NOTICE
+}
+
+// Link returns a link to detailed information about the rule
+func (r *ResourceNamePrefixRule) Link() string {
+	return r.link(r.Name())
+}
+
+// Check executes the rule checking process
+func (r *ResourceNamePrefixRule) Check(runner tflint.Runner) error {
+	// Decode rule configuration
+	config := resourceNamePrefixRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+	if len(config.Prefixes) == 0 {
+		return nil
+	}
+
+	files, fileNames, err := sortedFiles(runner)
+	if err != nil {
+		return err
+	}
+
+	for _, fileName := range fileNames {
+		body, ok := files[fileName].Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		for _, block := range body.Blocks {
+			if block.Type != "resource" || len(block.Labels) < 2 {
+				continue
+			}
+
+			prefix, configured := config.Prefixes[block.Labels[0]]
+			if !configured || strings.HasPrefix(block.Labels[1], prefix) {
+				continue
+			}
+
+			err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("Resource \"%s.%s\" name should start with \"%s\"", block.Labels[0], block.Labels[1], prefix),
+				block.LabelRanges[1],
+			)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
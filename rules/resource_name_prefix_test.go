@@ -0,0 +1,75 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestResourceNamePrefixRule(t *testing.T) {
+	config := `
+rule "resource_name_prefix" {
+  enabled = true
+  prefixes = {
+    aws_s3_bucket = "bucket_"
+  }
+}`
+
+	tests := []struct {
+		name     string
+		content  string
+		expected helper.Issues
+	}{
+		{
+			name: "compliant name",
+			content: `
+resource "aws_s3_bucket" "bucket_data" {
+  bucket = "data"
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "non-compliant name",
+			content: `
+resource "aws_s3_bucket" "data" {
+  bucket = "data"
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewResourceNamePrefixRule(),
+					Message: "Resource \"aws_s3_bucket.data\" name should start with \"bucket_\"",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 26},
+						End:      hcl.Pos{Line: 2, Column: 32},
+					},
+				},
+			},
+		},
+		{
+			name: "unconfigured resource type",
+			content: `
+resource "aws_instance" "web" {
+  ami = "ami-123456"
+}`,
+			expected: helper.Issues{},
+		},
+	}
+
+	rule := NewResourceNamePrefixRule()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{
+				"main.tf":     test.content,
+				".tflint.hcl": config,
+			})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,164 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// taggableResourceTypes are the resource types checked when resource_types is not configured
+var taggableResourceTypes = map[string]bool{
+	"aws_autoscaling_group":   true,
+	"aws_db_instance":         true,
+	"aws_dynamodb_table":      true,
+	"aws_ebs_volume":          true,
+	"aws_ecs_cluster":         true,
+	"aws_eip":                 true,
+	"aws_eks_cluster":         true,
+	"aws_elasticache_cluster": true,
+	"aws_instance":            true,
+	"aws_internet_gateway":    true,
+	"aws_kms_key":             true,
+	"aws_lambda_function":     true,
+	"aws_lb":                  true,
+	"aws_nat_gateway":         true,
+	"aws_rds_cluster":         true,
+	"aws_route_table":         true,
+	"aws_s3_bucket":           true,
+	"aws_security_group":      true,
+	"aws_sns_topic":           true,
+	"aws_sqs_queue":           true,
+	"aws_subnet":              true,
+	"aws_vpc":                 true,
+}
+
+// ResourceRequiredTagsRule checks that taggable resources set the required tags
+type ResourceRequiredTagsRule struct {
+	tflint.DefaultRule
+	docsLink
+}
+
+// resourceRequiredTagsRuleConfig is the configuration for the rule
+type resourceRequiredTagsRuleConfig struct {
+	RequiredTags  []string `hclext:"required_tags,optional"`
+	ResourceTypes []string `hclext:"resource_types,optional"`
+}
+
+// NewResourceRequiredTagsRule creates a new rule instance
+func NewResourceRequiredTagsRule() *ResourceRequiredTagsRule {
+	return &ResourceRequiredTagsRule{}
+}
+
+// Name returns the rule name
+func (r *ResourceRequiredTagsRule) Name() string {
+	return "resource_required_tags"
+}
+
+// Enabled returns whether the rule is enabled
+func (r *ResourceRequiredTagsRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *ResourceRequiredTagsRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns a link to detailed information about the rule
+func (r *ResourceRequiredTagsRule) Link() string {
+	return r.link(r.Name())
+}
+
+// Check executes the rule checking process
+func (r *ResourceRequiredTagsRule) Check(runner tflint.Runner) error {
+	// Decode rule configuration
+	config := resourceRequiredTagsRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+	if len(config.RequiredTags) == 0 {
+		return nil
+	}
+
+	resourceTypes := taggableResourceTypes
+	if len(config.ResourceTypes) > 0 {
+		resourceTypes = make(map[string]bool)
+		for _, t := range config.ResourceTypes {
+			resourceTypes[t] = true
+		}
+	}
+
+	files, fileNames, err := sortedFiles(runner)
+	if err != nil {
+		return err
+	}
+
+	for _, fileName := range fileNames {
+		body, ok := files[fileName].Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		for _, block := range body.Blocks {
+			if block.Type != "resource" || len(block.Labels) < 2 || !resourceTypes[block.Labels[0]] {
+				continue
+			}
+
+			rng := block.DefRange()
+			present := make(map[string]bool)
+			if tagsAttr, exists := block.Body.Attributes["tags"]; exists {
+				keys, ok := r.tagKeys(tagsAttr.Expr)
+				if !ok {
+					// Tags built dynamically (e.g. merge(var.tags, ...)) cannot be checked statically
+					continue
+				}
+				present = keys
+				rng = tagsAttr.Range()
+			}
+
+			for _, tag := range config.RequiredTags {
+				if present[tag] {
+					continue
+				}
+
+				err := runner.EmitIssue(
+					r,
+					fmt.Sprintf("Resource \"%s.%s\" is missing required tag \"%s\"", block.Labels[0], block.Labels[1], tag),
+					rng,
+				)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// tagKeys returns the keys of a tags object expression
+// It returns false when the expression is not an object literal with static keys
+func (r *ResourceRequiredTagsRule) tagKeys(expr hcl.Expression) (map[string]bool, bool) {
+	obj, ok := expr.(*hclsyntax.ObjectConsExpr)
+	if !ok {
+		return nil, false
+	}
+
+	keys := make(map[string]bool)
+	for _, item := range obj.Items {
+		// Bare identifiers (Owner = ...) are keywords, quoted keys ("Owner" = ...) are strings
+		if key := hcl.ExprAsKeyword(item.KeyExpr); key != "" {
+			keys[key] = true
+			continue
+		}
+		key, ok := literalString(item.KeyExpr)
+		if !ok {
+			return nil, false
+		}
+		keys[key] = true
+	}
+
+	return keys, true
+}
@@ -0,0 +1,118 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestResourceRequiredTagsRule(t *testing.T) {
+	config := `
+rule "resource_required_tags" {
+  enabled = true
+  required_tags = ["Environment", "Owner"]
+}`
+
+	tests := []struct {
+		name     string
+		content  string
+		config   string
+		expected helper.Issues
+	}{
+		{
+			name: "fully tagged resource",
+			content: `
+resource "aws_instance" "web" {
+  ami = "ami-123456"
+  tags = {
+    Environment = "production"
+    "Owner"     = "platform"
+  }
+}`,
+			config:   config,
+			expected: helper.Issues{},
+		},
+		{
+			name: "partially tagged resource",
+			content: `
+resource "aws_instance" "web" {
+  ami = "ami-123456"
+  tags = {
+    Environment = "production"
+  }
+}`,
+			config: config,
+			expected: helper.Issues{
+				{
+					Rule:    NewResourceRequiredTagsRule(),
+					Message: "Resource \"aws_instance.web\" is missing required tag \"Owner\"",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 4, Column: 3},
+						End:      hcl.Pos{Line: 6, Column: 4},
+					},
+				},
+			},
+		},
+		{
+			name: "untagged resource",
+			content: `
+resource "aws_instance" "web" {
+  ami = "ami-123456"
+}`,
+			config: config,
+			expected: helper.Issues{
+				{
+					Rule:    NewResourceRequiredTagsRule(),
+					Message: "Resource \"aws_instance.web\" is missing required tag \"Environment\"",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 30},
+					},
+				},
+				{
+					Rule:    NewResourceRequiredTagsRule(),
+					Message: "Resource \"aws_instance.web\" is missing required tag \"Owner\"",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 30},
+					},
+				},
+			},
+		},
+		{
+			name: "resource type outside the allowlist",
+			content: `
+resource "aws_instance" "web" {
+  ami = "ami-123456"
+}`,
+			config: `
+rule "resource_required_tags" {
+  enabled = true
+  required_tags = ["Owner"]
+  resource_types = ["aws_s3_bucket"]
+}`,
+			expected: helper.Issues{},
+		},
+	}
+
+	rule := NewResourceRequiredTagsRule()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			files := map[string]string{"main.tf": test.content}
+			if test.config != "" {
+				files[".tflint.hcl"] = test.config
+			}
+			runner := helper.TestRunner(t, files)
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.expected, runner.Issues)
+		})
+	}
+}
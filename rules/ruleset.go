@@ -0,0 +1,105 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2/gohcl"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// defaultDocsURL is the documentation linked from every rule unless docs_base_url is configured
+const defaultDocsURL = "https://github.com/takaishi/tflint-ruleset-takaishi"
+
+// RuleSet is the ruleset served by this plugin
+type RuleSet struct {
+	tflint.BuiltinRuleSet
+
+	// DocsBaseURL overrides the documentation linked from each rule as DocsBaseURL/<rule name>
+	// It can also be set with docs_base_url in the plugin block
+	DocsBaseURL string
+}
+
+// togglableRule is a rule whose enabled state can be updated after construction
+type togglableRule interface {
+	SetEnabled(enabled bool)
+}
+
+// linkableRule is a rule whose documentation link can be pointed at another site
+type linkableRule interface {
+	SetDocsBaseURL(baseURL string)
+}
+
+// docsLink is embedded in rules to build their Link from an overridable base URL
+type docsLink struct {
+	baseURL string
+}
+
+// SetDocsBaseURL updates the base URL of the rule documentation
+func (d *docsLink) SetDocsBaseURL(baseURL string) {
+	d.baseURL = baseURL
+}
+
+// link returns the documentation link for the named rule
+func (d *docsLink) link(name string) string {
+	if d.baseURL == "" {
+		return defaultDocsURL
+	}
+	return strings.TrimSuffix(d.baseURL, "/") + "/" + name
+}
+
+// ApplyGlobalConfig applies the common config and reflects the enabled state on each rule
+func (r *RuleSet) ApplyGlobalConfig(config *tflint.Config) error {
+	if err := r.BuiltinRuleSet.ApplyGlobalConfig(config); err != nil {
+		return err
+	}
+
+	enabled := make(map[string]bool)
+	for _, rule := range r.EnabledRules {
+		enabled[rule.Name()] = true
+	}
+
+	for _, rule := range r.Rules {
+		if t, ok := rule.(togglableRule); ok {
+			t.SetEnabled(enabled[rule.Name()])
+		}
+	}
+
+	r.applyDocsBaseURL()
+
+	return nil
+}
+
+// ConfigSchema returns the schema of the plugin block
+func (r *RuleSet) ConfigSchema() *hclext.BodySchema {
+	return &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: "docs_base_url"},
+		},
+	}
+}
+
+// ApplyConfig applies the plugin block configuration to each rule
+func (r *RuleSet) ApplyConfig(content *hclext.BodyContent) error {
+	if attr, exists := content.Attributes["docs_base_url"]; exists {
+		var baseURL string
+		if diags := gohcl.DecodeExpression(attr.Expr, nil, &baseURL); diags.HasErrors() {
+			return fmt.Errorf("invalid docs_base_url: %w", diags)
+		}
+		r.DocsBaseURL = baseURL
+	}
+
+	r.applyDocsBaseURL()
+
+	return nil
+}
+
+// applyDocsBaseURL injects the documentation base URL into each rule
+func (r *RuleSet) applyDocsBaseURL() {
+	for _, rule := range r.Rules {
+		if l, ok := rule.(linkableRule); ok {
+			l.SetDocsBaseURL(r.DocsBaseURL)
+		}
+	}
+}
@@ -0,0 +1,177 @@
+package rules
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+func TestRuleSetApplyGlobalConfig(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   *tflint.Config
+		expected bool
+	}{
+		{
+			name:     "disabled by default",
+			config:   &tflint.Config{Rules: map[string]*tflint.RuleConfig{}},
+			expected: false,
+		},
+		{
+			name: "enabled by rule config",
+			config: &tflint.Config{
+				Rules: map[string]*tflint.RuleConfig{
+					"module_circular_dependency": {Name: "module_circular_dependency", Enabled: true},
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "enabled by only option",
+			config: &tflint.Config{
+				Rules: map[string]*tflint.RuleConfig{},
+				Only:  []string{"module_circular_dependency"},
+			},
+			expected: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rule := NewModuleCircularDependencyRule()
+			ruleset := &RuleSet{
+				BuiltinRuleSet: tflint.BuiltinRuleSet{
+					Name:  "takaishi",
+					Rules: []tflint.Rule{rule},
+				},
+			}
+
+			if err := ruleset.ApplyGlobalConfig(test.config); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			if rule.Enabled() != test.expected {
+				t.Errorf("Expected Enabled() to be %t, got %t", test.expected, rule.Enabled())
+			}
+			if enabled := len(ruleset.EnabledRules) == 1; enabled != test.expected {
+				t.Errorf("Expected rule to be registered as enabled: %t, got %t", test.expected, enabled)
+			}
+		})
+	}
+}
+
+func TestRuleSetRunsEnabledRule(t *testing.T) {
+	rule := NewModuleCircularDependencyRule()
+	ruleset := &RuleSet{
+		BuiltinRuleSet: tflint.BuiltinRuleSet{
+			Name:  "takaishi",
+			Rules: []tflint.Rule{rule},
+		},
+	}
+
+	config := &tflint.Config{
+		Rules: map[string]*tflint.RuleConfig{
+			"module_circular_dependency": {Name: "module_circular_dependency", Enabled: true},
+		},
+	}
+	if err := ruleset.ApplyGlobalConfig(config); err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+
+	runner := helper.TestRunner(t, map[string]string{
+		"main.tf": `
+module "module_a" {
+  source = "./modules/a"
+  input = module.module_b.output
+}
+
+module "module_b" {
+  source = "./modules/b"
+  input = module.module_a.output
+}`,
+		".tflint.hcl": `
+rule "module_circular_dependency" {
+  enabled = true
+}`,
+	})
+
+	for _, enabledRule := range ruleset.EnabledRules {
+		if err := enabledRule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+	}
+
+	helper.AssertIssuesWithoutRange(t, helper.Issues{
+		{
+			Rule:    rule,
+			Message: "Circular dependency detected between modules: module_a ↔ module_b (see https://github.com/takaishi/tflint-ruleset-takaishi#direct-cycles)",
+		},
+		{
+			Rule:    noticeRule{rule},
+			Message: "Circular dependency detected between modules: module_b ↔ module_a (see https://github.com/takaishi/tflint-ruleset-takaishi#direct-cycles)",
+		},
+	}, runner.Issues)
+}
+
+func TestRuleSetDocsBaseURL(t *testing.T) {
+	tests := []struct {
+		name        string
+		docsBaseURL string
+		pluginURL   string
+		expected    string
+	}{
+		{
+			name:     "default link",
+			expected: "https://github.com/takaishi/tflint-ruleset-takaishi",
+		},
+		{
+			name:        "base URL set at construction",
+			docsBaseURL: "https://docs.example.com/tflint/",
+			expected:    "https://docs.example.com/tflint/module_circular_dependency",
+		},
+		{
+			name:        "base URL set in plugin block",
+			docsBaseURL: "https://docs.example.com/tflint",
+			pluginURL:   "https://internal.example.com/rules",
+			expected:    "https://internal.example.com/rules/module_circular_dependency",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rule := NewModuleCircularDependencyRule()
+			ruleset := &RuleSet{
+				BuiltinRuleSet: tflint.BuiltinRuleSet{
+					Name:  "takaishi",
+					Rules: []tflint.Rule{rule},
+				},
+				DocsBaseURL: test.docsBaseURL,
+			}
+
+			if err := ruleset.ApplyGlobalConfig(&tflint.Config{Rules: map[string]*tflint.RuleConfig{}}); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			content := &hclext.BodyContent{Attributes: hclext.Attributes{}}
+			if test.pluginURL != "" {
+				expr, diags := hclsyntax.ParseExpression([]byte(fmt.Sprintf("%q", test.pluginURL)), ".tflint.hcl", hcl.InitialPos)
+				if diags.HasErrors() {
+					t.Fatal(diags)
+				}
+				content.Attributes["docs_base_url"] = &hclext.Attribute{Name: "docs_base_url", Expr: expr}
+			}
+			if err := ruleset.ApplyConfig(content); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			if link := rule.Link(); link != test.expected {
+				t.Errorf("Expected Link() to be %q, got %q", test.expected, link)
+			}
+		})
+	}
+}
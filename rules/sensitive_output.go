@@ -0,0 +1,137 @@
+package rules
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// defaultSensitivePatterns are the name patterns used when patterns is not configured
+var defaultSensitivePatterns = []string{"password", "secret", "token", "key"}
+
+// SensitiveOutputRule checks that outputs with sensitive-looking names are marked sensitive
+type SensitiveOutputRule struct {
+	tflint.DefaultRule
+	docsLink
+}
+
+// sensitiveOutputRuleConfig is the configuration for the rule
+type sensitiveOutputRuleConfig struct {
+	Patterns []string `hclext:"patterns,optional"`
+}
+
+// NewSensitiveOutputRule creates a new rule instance
+func NewSensitiveOutputRule() *SensitiveOutputRule {
+	return &SensitiveOutputRule{}
+}
+
+// Name returns the rule name
+func (r *SensitiveOutputRule) Name() string {
+	return "sensitive_output"
+}
+
+// Enabled returns whether the rule is enabled
+func (r *SensitiveOutputRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *SensitiveOutputRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns a link to detailed information about the rule
+func (r *SensitiveOutputRule) Link() string {
+	return r.link(r.Name())
+}
+
+// Check executes the rule checking process
+func (r *SensitiveOutputRule) Check(runner tflint.Runner) error {
+	// Decode rule configuration
+	config := sensitiveOutputRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+	if len(config.Patterns) == 0 {
+		config.Patterns = defaultSensitivePatterns
+	}
+
+	patterns, err := compilePatterns(config.Patterns)
+	if err != nil {
+		return err
+	}
+
+	files, fileNames, err := sortedFiles(runner)
+	if err != nil {
+		return err
+	}
+
+	for _, fileName := range fileNames {
+		body, ok := files[fileName].Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		for _, block := range body.Blocks {
+			if block.Type != "output" || len(block.Labels) == 0 {
+				continue
+			}
+
+			outputName := block.Labels[0]
+			if !matchesAnyPattern(outputName, patterns) || isSensitiveBlock(block) {
+				continue
+			}
+
+			err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("Output \"%s\" should be marked sensitive = true", outputName),
+				block.DefRange(),
+			)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// compilePatterns compiles the configured name patterns
+func compilePatterns(exprs []string) ([]*regexp.Regexp, error) {
+	var patterns []*regexp.Regexp
+	for _, p := range exprs {
+		pattern, err := regexp.Compile(p)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern %q: %w", p, err)
+		}
+		patterns = append(patterns, pattern)
+	}
+	return patterns, nil
+}
+
+// matchesAnyPattern returns whether the name matches any of the patterns
+func matchesAnyPattern(name string, patterns []*regexp.Regexp) bool {
+	for _, pattern := range patterns {
+		if pattern.MatchString(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// isSensitiveBlock returns whether the output or variable block sets sensitive = true
+func isSensitiveBlock(block *hclsyntax.Block) bool {
+	attr, exists := block.Body.Attributes["sensitive"]
+	if !exists {
+		return false
+	}
+
+	val, diags := attr.Expr.Value(nil)
+	if diags.HasErrors() || val.IsNull() || !val.IsKnown() || !val.Type().Equals(cty.Bool) {
+		return false
+	}
+	return val.True()
+}
@@ -0,0 +1,97 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestSensitiveOutputRule(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		config   string
+		expected helper.Issues
+	}{
+		{
+			name: "matching output not marked sensitive",
+			content: `
+output "db_password" {
+  value = "secret"
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewSensitiveOutputRule(),
+					Message: "Output \"db_password\" should be marked sensitive = true",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 21},
+					},
+				},
+			},
+		},
+		{
+			name: "matching output marked sensitive",
+			content: `
+output "db_password" {
+  value     = "secret"
+  sensitive = true
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "non-matching output",
+			content: `
+output "instance_ip" {
+  value = "10.0.0.1"
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "custom patterns",
+			content: `
+output "db_password" {
+  value = "secret"
+}
+
+output "private_cert" {
+  value = "cert"
+}`,
+			config: `
+rule "sensitive_output" {
+  enabled = true
+  patterns = ["^private_"]
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewSensitiveOutputRule(),
+					Message: "Output \"private_cert\" should be marked sensitive = true",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 6, Column: 1},
+						End:      hcl.Pos{Line: 6, Column: 22},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewSensitiveOutputRule()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			files := map[string]string{"main.tf": test.content}
+			if test.config != "" {
+				files[".tflint.hcl"] = test.config
+			}
+			runner := helper.TestRunner(t, files)
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// SensitiveVariableRule checks that variables with sensitive-looking names are marked sensitive
+type SensitiveVariableRule struct {
+	tflint.DefaultRule
+	docsLink
+}
+
+// sensitiveVariableRuleConfig is the configuration for the rule
+type sensitiveVariableRuleConfig struct {
+	Patterns []string `hclext:"patterns,optional"`
+}
+
+// NewSensitiveVariableRule creates a new rule instance
+func NewSensitiveVariableRule() *SensitiveVariableRule {
+	return &SensitiveVariableRule{}
+}
+
+// Name returns the rule name
+func (r *SensitiveVariableRule) Name() string {
+	return "sensitive_variable"
+}
+
+// Enabled returns whether the rule is enabled
+func (r *SensitiveVariableRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *SensitiveVariableRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns a link to detailed information about the rule
+func (r *SensitiveVariableRule) Link() string {
+	return r.link(r.Name())
+}
+
+// Check executes the rule checking process
+func (r *SensitiveVariableRule) Check(runner tflint.Runner) error {
+	// Decode rule configuration
+	config := sensitiveVariableRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+	if len(config.Patterns) == 0 {
+		config.Patterns = defaultSensitivePatterns
+	}
+
+	patterns, err := compilePatterns(config.Patterns)
+	if err != nil {
+		return err
+	}
+
+	files, fileNames, err := sortedFiles(runner)
+	if err != nil {
+		return err
+	}
+
+	for _, fileName := range fileNames {
+		body, ok := files[fileName].Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		for _, block := range body.Blocks {
+			if block.Type != "variable" || len(block.Labels) == 0 {
+				continue
+			}
+
+			variableName := block.Labels[0]
+			if !matchesAnyPattern(variableName, patterns) || isSensitiveBlock(block) {
+				continue
+			}
+
+			err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("Variable \"%s\" should be marked sensitive = true", variableName),
+				block.DefRange(),
+			)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestSensitiveVariableRule(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		config   string
+		expected helper.Issues
+	}{
+		{
+			name: "matching variable not marked sensitive",
+			content: `
+variable "db_password" {
+  type = string
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewSensitiveVariableRule(),
+					Message: "Variable \"db_password\" should be marked sensitive = true",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 23},
+					},
+				},
+			},
+		},
+		{
+			name: "matching variable marked sensitive",
+			content: `
+variable "db_password" {
+  type      = string
+  sensitive = true
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "non-matching variable",
+			content: `
+variable "instance_type" {
+  type = string
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "custom patterns",
+			content: `
+variable "db_password" {
+  type = string
+}
+
+variable "private_cert" {
+  type = string
+}`,
+			config: `
+rule "sensitive_variable" {
+  enabled = true
+  patterns = ["^private_"]
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewSensitiveVariableRule(),
+					Message: "Variable \"private_cert\" should be marked sensitive = true",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 6, Column: 1},
+						End:      hcl.Pos{Line: 6, Column: 24},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewSensitiveVariableRule()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			files := map[string]string{"main.tf": test.content}
+			if test.config != "" {
+				files[".tflint.hcl"] = test.config
+			}
+			runner := helper.TestRunner(t, files)
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.expected, runner.Issues)
+		})
+	}
+}
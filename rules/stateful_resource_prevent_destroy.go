@@ -0,0 +1,123 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// defaultStatefulResourceTypes are the resource types checked when types is not configured
+var defaultStatefulResourceTypes = []string{"aws_db_instance", "aws_s3_bucket"}
+
+// StatefulResourcePreventDestroyRule checks that stateful resources are protected from destruction
+type StatefulResourcePreventDestroyRule struct {
+	tflint.DefaultRule
+	docsLink
+}
+
+// statefulResourcePreventDestroyRuleConfig is the configuration for the rule
+type statefulResourcePreventDestroyRuleConfig struct {
+	Types []string `hclext:"types,optional"`
+}
+
+// NewStatefulResourcePreventDestroyRule creates a new rule instance
+func NewStatefulResourcePreventDestroyRule() *StatefulResourcePreventDestroyRule {
+	return &StatefulResourcePreventDestroyRule{}
+}
+
+// Name returns the rule name
+func (r *StatefulResourcePreventDestroyRule) Name() string {
+	return "stateful_resource_prevent_destroy"
+}
+
+// Enabled returns whether the rule is enabled
+func (r *StatefulResourcePreventDestroyRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *StatefulResourcePreventDestroyRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns a link to detailed information about the rule
+func (r *StatefulResourcePreventDestroyRule) Link() string {
+	return r.link(r.Name())
+}
+
+// Check executes the rule checking process
+func (r *StatefulResourcePreventDestroyRule) Check(runner tflint.Runner) error {
+	// Decode rule configuration
+	config := statefulResourcePreventDestroyRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+	if len(config.Types) == 0 {
+		config.Types = defaultStatefulResourceTypes
+	}
+
+	statefulTypes := make(map[string]bool)
+	for _, t := range config.Types {
+		statefulTypes[t] = true
+	}
+
+	files, fileNames, err := sortedFiles(runner)
+	if err != nil {
+		return err
+	}
+
+	for _, fileName := range fileNames {
+		body, ok := files[fileName].Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		for _, block := range body.Blocks {
+			if block.Type != "resource" || len(block.Labels) < 2 || !statefulTypes[block.Labels[0]] {
+				continue
+			}
+
+			rng, protected := r.preventDestroy(block)
+			if protected {
+				continue
+			}
+
+			err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("Resource \"%s.%s\" should set lifecycle { prevent_destroy = true }", block.Labels[0], block.Labels[1]),
+				rng,
+			)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// preventDestroy returns whether a resource sets prevent_destroy = true
+// The returned range points at the prevent_destroy attribute when present, and at the resource otherwise
+func (r *StatefulResourcePreventDestroyRule) preventDestroy(block *hclsyntax.Block) (hcl.Range, bool) {
+	for _, inner := range block.Body.Blocks {
+		if inner.Type != "lifecycle" {
+			continue
+		}
+
+		attr, exists := inner.Body.Attributes["prevent_destroy"]
+		if !exists {
+			continue
+		}
+
+		val, diags := attr.Expr.Value(nil)
+		if diags.HasErrors() || val.IsNull() || !val.IsKnown() || !val.Type().Equals(cty.Bool) {
+			return attr.Range(), false
+		}
+		return attr.Range(), val.True()
+	}
+
+	return block.DefRange(), false
+}
@@ -0,0 +1,122 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestStatefulResourcePreventDestroyRule(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		config   string
+		expected helper.Issues
+	}{
+		{
+			name: "protected resource",
+			content: `
+resource "aws_db_instance" "db" {
+  engine = "postgres"
+
+  lifecycle {
+    prevent_destroy = true
+  }
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "resource without lifecycle block",
+			content: `
+resource "aws_db_instance" "db" {
+  engine = "postgres"
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewStatefulResourcePreventDestroyRule(),
+					Message: "Resource \"aws_db_instance.db\" should set lifecycle { prevent_destroy = true }",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 32},
+					},
+				},
+			},
+		},
+		{
+			name: "resource with prevent_destroy disabled",
+			content: `
+resource "aws_s3_bucket" "logs" {
+  bucket = "logs"
+
+  lifecycle {
+    prevent_destroy = false
+  }
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewStatefulResourcePreventDestroyRule(),
+					Message: "Resource \"aws_s3_bucket.logs\" should set lifecycle { prevent_destroy = true }",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 6, Column: 5},
+						End:      hcl.Pos{Line: 6, Column: 28},
+					},
+				},
+			},
+		},
+		{
+			name: "non-stateful resource",
+			content: `
+resource "aws_instance" "web" {
+  ami = "ami-123456"
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "custom types",
+			content: `
+resource "aws_db_instance" "db" {
+  engine = "postgres"
+}
+
+resource "aws_dynamodb_table" "table" {
+  name = "table"
+}`,
+			config: `
+rule "stateful_resource_prevent_destroy" {
+  enabled = true
+  types = ["aws_dynamodb_table"]
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewStatefulResourcePreventDestroyRule(),
+					Message: "Resource \"aws_dynamodb_table.table\" should set lifecycle { prevent_destroy = true }",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 6, Column: 1},
+						End:      hcl.Pos{Line: 6, Column: 38},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewStatefulResourcePreventDestroyRule()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			files := map[string]string{"main.tf": test.content}
+			if test.config != "" {
+				files[".tflint.hcl"] = test.config
+			}
+			runner := helper.TestRunner(t, files)
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package rules
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// UnusedLocalRule checks for local values that are never referenced
+type UnusedLocalRule struct {
+	tflint.DefaultRule
+	docsLink
+}
+
+// NewUnusedLocalRule creates a new rule instance
+func NewUnusedLocalRule() *UnusedLocalRule {
+	return &UnusedLocalRule{}
+}
+
+// Name returns the rule name
+func (r *UnusedLocalRule) Name() string {
+	return "unused_local"
+}
+
+// Enabled returns whether the rule is enabled
+func (r *UnusedLocalRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *UnusedLocalRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns a link to detailed information about the rule
+func (r *UnusedLocalRule) Link() string {
+	return r.link(r.Name())
+}
+
+// Check executes the rule checking process
+func (r *UnusedLocalRule) Check(runner tflint.Runner) error {
+	files, fileNames, err := sortedFiles(runner)
+	if err != nil {
+		return err
+	}
+
+	// Collect every local.<name> reference, including references from other locals
+	used := make(map[string]bool)
+	for _, fileName := range fileNames {
+		body, ok := files[fileName].Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		walkAttributes(body, func(attr *hclsyntax.Attribute) {
+			for _, name := range findReferences(attr.Expr, "local") {
+				used[name] = true
+			}
+		})
+	}
+
+	for _, fileName := range fileNames {
+		body, ok := files[fileName].Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		for _, block := range body.Blocks {
+			if block.Type != "locals" {
+				continue
+			}
+
+			// Sort attributes by position (by line number)
+			var attrs []*hclsyntax.Attribute
+			for _, attr := range block.Body.Attributes {
+				attrs = append(attrs, attr)
+			}
+			sort.Slice(attrs, func(i, j int) bool {
+				return attrs[i].Range().Start.Line < attrs[j].Range().Start.Line
+			})
+
+			for _, attr := range attrs {
+				if used[attr.Name] {
+					continue
+				}
+
+				err := runner.EmitIssue(
+					r,
+					fmt.Sprintf("Local value \"%s\" is defined but never used", attr.Name),
+					attr.NameRange,
+				)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,93 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestUnusedLocalRule(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected helper.Issues
+	}{
+		{
+			name: "used local",
+			content: `
+locals {
+  name = "app"
+}
+
+module "module_a" {
+  source = "./modules/a"
+  name = local.name
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "local used in interpolation-only string",
+			content: `
+locals {
+  a = "app"
+}
+
+module "module_a" {
+  source = "./modules/a"
+  name = "${local.a}"
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "unused local",
+			content: `
+locals {
+  name   = "app"
+  unused = "value"
+}
+
+module "module_a" {
+  source = "./modules/a"
+  name = "${local.name}-a"
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewUnusedLocalRule(),
+					Message: "Local value \"unused\" is defined but never used",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 4, Column: 3},
+						End:      hcl.Pos{Line: 4, Column: 9},
+					},
+				},
+			},
+		},
+		{
+			name: "local used only by another local",
+			content: `
+locals {
+  prefix = "app"
+  name   = "${local.prefix}-name"
+}
+
+output "name" {
+  value = local.name
+}`,
+			expected: helper.Issues{},
+		},
+	}
+
+	rule := NewUnusedLocalRule()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": test.content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// UnusedVariableRule checks for variables that are never referenced
+type UnusedVariableRule struct {
+	tflint.DefaultRule
+	docsLink
+}
+
+// unusedVariableRuleConfig is the configuration for the rule
+type unusedVariableRuleConfig struct {
+	Exclude []string `hclext:"exclude,optional"`
+}
+
+// NewUnusedVariableRule creates a new rule instance
+func NewUnusedVariableRule() *UnusedVariableRule {
+	return &UnusedVariableRule{}
+}
+
+// Name returns the rule name
+func (r *UnusedVariableRule) Name() string {
+	return "unused_variable"
+}
+
+// Enabled returns whether the rule is enabled
+func (r *UnusedVariableRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *UnusedVariableRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns a link to detailed information about the rule
+func (r *UnusedVariableRule) Link() string {
+	return r.link(r.Name())
+}
+
+// Check executes the rule checking process
+func (r *UnusedVariableRule) Check(runner tflint.Runner) error {
+	// Decode rule configuration
+	config := unusedVariableRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	excluded := make(map[string]bool)
+	for _, name := range config.Exclude {
+		excluded[name] = true
+	}
+
+	files, fileNames, err := sortedFiles(runner)
+	if err != nil {
+		return err
+	}
+
+	// Collect every var.<name> reference
+	used := make(map[string]bool)
+	for _, fileName := range fileNames {
+		body, ok := files[fileName].Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		walkAttributes(body, func(attr *hclsyntax.Attribute) {
+			for _, name := range findReferences(attr.Expr, "var") {
+				used[name] = true
+			}
+		})
+	}
+
+	for _, fileName := range fileNames {
+		body, ok := files[fileName].Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		for _, block := range body.Blocks {
+			if block.Type != "variable" || len(block.Labels) == 0 {
+				continue
+			}
+
+			name := block.Labels[0]
+			if used[name] || excluded[name] {
+				continue
+			}
+
+			err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("Variable \"%s\" is declared but never referenced", name),
+				block.DefRange(),
+			)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
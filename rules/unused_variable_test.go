@@ -0,0 +1,104 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestUnusedVariableRule(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		config   string
+		expected helper.Issues
+	}{
+		{
+			name: "used variable",
+			content: `
+variable "region" {
+  type = string
+}
+
+module "module_a" {
+  source = "./modules/a"
+  region = var.region
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "variable used in interpolation-only string",
+			content: `
+variable "name" {
+  type = string
+}
+
+resource "aws_s3_bucket" "logs" {
+  bucket = "${var.name}"
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "variable used in negation",
+			content: `
+variable "flag" {
+  type = bool
+}
+
+module "module_a" {
+  source  = "./modules/a"
+  enabled = !var.flag
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "unused variable",
+			content: `
+variable "unused" {
+  type = string
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewUnusedVariableRule(),
+					Message: "Variable \"unused\" is declared but never referenced",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 18},
+					},
+				},
+			},
+		},
+		{
+			name: "excluded variable",
+			content: `
+variable "unused" {
+  type = string
+}`,
+			config: `
+rule "unused_variable" {
+  enabled = true
+  exclude = ["unused"]
+}`,
+			expected: helper.Issues{},
+		},
+	}
+
+	rule := NewUnusedVariableRule()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			files := map[string]string{"main.tf": test.content}
+			if test.config != "" {
+				files[".tflint.hcl"] = test.config
+			}
+			runner := helper.TestRunner(t, files)
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// VariableDefaultPolicyRule checks that variables follow the configured default value policy
+type VariableDefaultPolicyRule struct {
+	tflint.DefaultRule
+	docsLink
+}
+
+// variableDefaultPolicyRuleConfig is the configuration for the rule
+type variableDefaultPolicyRuleConfig struct {
+	RequireDefault []string `hclext:"require_default,optional"`
+	ForbidDefault  []string `hclext:"forbid_default,optional"`
+}
+
+// NewVariableDefaultPolicyRule creates a new rule instance
+func NewVariableDefaultPolicyRule() *VariableDefaultPolicyRule {
+	return &VariableDefaultPolicyRule{}
+}
+
+// Name returns the rule name
+func (r *VariableDefaultPolicyRule) Name() string {
+	return "variable_default_policy"
+}
+
+// Enabled returns whether the rule is enabled
+func (r *VariableDefaultPolicyRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *VariableDefaultPolicyRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns a link to detailed information about the rule
+func (r *VariableDefaultPolicyRule) Link() string {
+	return r.link(r.Name())
+}
+
+// Check executes the rule checking process
+func (r *VariableDefaultPolicyRule) Check(runner tflint.Runner) error {
+	// Decode rule configuration
+	config := variableDefaultPolicyRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	requirePatterns, err := compilePatterns(config.RequireDefault)
+	if err != nil {
+		return err
+	}
+	forbidPatterns, err := compilePatterns(config.ForbidDefault)
+	if err != nil {
+		return err
+	}
+
+	files, fileNames, err := sortedFiles(runner)
+	if err != nil {
+		return err
+	}
+
+	for _, fileName := range fileNames {
+		body, ok := files[fileName].Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		for _, block := range body.Blocks {
+			if block.Type != "variable" || len(block.Labels) == 0 {
+				continue
+			}
+
+			variableName := block.Labels[0]
+			defaultAttr, hasDefault := block.Body.Attributes["default"]
+
+			if !hasDefault && matchesAnyPattern(variableName, requirePatterns) {
+				err := runner.EmitIssue(
+					r,
+					fmt.Sprintf("Variable \"%s\" must have a default value", variableName),
+					block.DefRange(),
+				)
+				if err != nil {
+					return err
+				}
+			}
+
+			if hasDefault && matchesAnyPattern(variableName, forbidPatterns) {
+				err := runner.EmitIssue(
+					r,
+					fmt.Sprintf("Variable \"%s\" must not have a default value", variableName),
+					defaultAttr.Range(),
+				)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
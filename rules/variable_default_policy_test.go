@@ -0,0 +1,94 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestVariableDefaultPolicyRule(t *testing.T) {
+	config := `
+rule "variable_default_policy" {
+  enabled         = true
+  require_default = ["_override$"]
+  forbid_default  = ["^environment$"]
+}`
+
+	tests := []struct {
+		name     string
+		content  string
+		expected helper.Issues
+	}{
+		{
+			name: "required default is missing",
+			content: `
+variable "instance_type_override" {
+  type = string
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewVariableDefaultPolicyRule(),
+					Message: "Variable \"instance_type_override\" must have a default value",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 34},
+					},
+				},
+			},
+		},
+		{
+			name: "forbidden default is set",
+			content: `
+variable "environment" {
+  type    = string
+  default = "dev"
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewVariableDefaultPolicyRule(),
+					Message: "Variable \"environment\" must not have a default value",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 4, Column: 3},
+						End:      hcl.Pos{Line: 4, Column: 18},
+					},
+				},
+			},
+		},
+		{
+			name: "variables following the policy",
+			content: `
+variable "instance_type_override" {
+  type    = string
+  default = null
+}
+
+variable "environment" {
+  type = string
+}
+
+variable "region" {
+  type = string
+}`,
+			expected: helper.Issues{},
+		},
+	}
+
+	rule := NewVariableDefaultPolicyRule()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{
+				"main.tf":     test.content,
+				".tflint.hcl": config,
+			})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.expected, runner.Issues)
+		})
+	}
+}
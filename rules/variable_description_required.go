@@ -0,0 +1,110 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// VariableDescriptionRequiredRule checks that every variable has a description
+type VariableDescriptionRequiredRule struct {
+	tflint.DefaultRule
+	docsLink
+}
+
+// variableDescriptionRequiredRuleConfig is the configuration for the rule
+type variableDescriptionRequiredRuleConfig struct {
+	MinLength int `hclext:"min_length,optional"`
+}
+
+// NewVariableDescriptionRequiredRule creates a new rule instance
+func NewVariableDescriptionRequiredRule() *VariableDescriptionRequiredRule {
+	return &VariableDescriptionRequiredRule{}
+}
+
+// Name returns the rule name
+func (r *VariableDescriptionRequiredRule) Name() string {
+	return "variable_description_required"
+}
+
+// Enabled returns whether the rule is enabled
+func (r *VariableDescriptionRequiredRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *VariableDescriptionRequiredRule) Severity() tflint.Severity {
+	return tflint.NOTICE
+}
+
+// Link returns a link to detailed information about the rule
+func (r *VariableDescriptionRequiredRule) Link() string {
+	return r.link(r.Name())
+}
+
+// Check executes the rule checking process
+func (r *VariableDescriptionRequiredRule) Check(runner tflint.Runner) error {
+	// Decode rule configuration
+	config := variableDescriptionRequiredRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	files, fileNames, err := sortedFiles(runner)
+	if err != nil {
+		return err
+	}
+
+	for _, fileName := range fileNames {
+		body, ok := files[fileName].Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		for _, block := range body.Blocks {
+			if block.Type != "variable" || len(block.Labels) == 0 {
+				continue
+			}
+
+			message, rng, ok := checkDescription("Variable", block, config.MinLength)
+			if ok {
+				continue
+			}
+
+			if err := runner.EmitIssue(r, message, rng); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkDescription checks the description attribute of a labeled block such as a variable or output
+// It returns false with the message and range to report when the description is missing, empty or shorter than minLength
+func checkDescription(kind string, block *hclsyntax.Block, minLength int) (string, hcl.Range, bool) {
+	attr, exists := block.Body.Attributes["description"]
+	if !exists {
+		return fmt.Sprintf("%s \"%s\" is missing a description", kind, block.Labels[0]), block.DefRange(), false
+	}
+
+	description, ok := literalString(attr.Expr)
+	if !ok {
+		// Descriptions that are not static strings cannot be checked
+		return "", hcl.Range{}, true
+	}
+
+	description = strings.TrimSpace(description)
+	if description == "" {
+		return fmt.Sprintf("%s \"%s\" is missing a description", kind, block.Labels[0]), attr.Range(), false
+	}
+	if utf8.RuneCountInString(description) < minLength {
+		return fmt.Sprintf("%s \"%s\" description must be at least %d characters", kind, block.Labels[0], minLength), attr.Range(), false
+	}
+
+	return "", hcl.Range{}, true
+}
@@ -0,0 +1,143 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestVariableDescriptionRequiredRule(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		config   string
+		expected helper.Issues
+	}{
+		{
+			name: "described variable",
+			content: `
+variable "region" {
+  description = "AWS region to deploy into"
+  type        = string
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "empty description",
+			content: `
+variable "region" {
+  description = ""
+  type        = string
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewVariableDescriptionRequiredRule(),
+					Message: "Variable \"region\" is missing a description",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 19},
+					},
+				},
+			},
+		},
+		{
+			name: "undescribed variable",
+			content: `
+variable "region" {
+  type = string
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewVariableDescriptionRequiredRule(),
+					Message: "Variable \"region\" is missing a description",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 18},
+					},
+				},
+			},
+		},
+		{
+			name: "description shorter than min_length",
+			content: `
+variable "region" {
+  description = "Region"
+  type        = string
+}`,
+			config: `
+rule "variable_description_required" {
+  enabled = true
+  min_length = 10
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewVariableDescriptionRequiredRule(),
+					Message: "Variable \"region\" description must be at least 10 characters",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 25},
+					},
+				},
+			},
+		},
+		{
+			name: "multibyte description shorter than min_length",
+			content: `
+variable "region" {
+  description = "リージョン"
+  type        = string
+}`,
+			config: `
+rule "variable_description_required" {
+  enabled = true
+  min_length = 10
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewVariableDescriptionRequiredRule(),
+					Message: "Variable \"region\" description must be at least 10 characters",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 24},
+					},
+				},
+			},
+		},
+		{
+			name: "description meeting min_length",
+			content: `
+variable "region" {
+  description = "AWS region to deploy into"
+  type        = string
+}`,
+			config: `
+rule "variable_description_required" {
+  enabled = true
+  min_length = 10
+}`,
+			expected: helper.Issues{},
+		},
+	}
+
+	rule := NewVariableDescriptionRequiredRule()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			files := map[string]string{"main.tf": test.content}
+			if test.config != "" {
+				files[".tflint.hcl"] = test.config
+			}
+			runner := helper.TestRunner(t, files)
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// VariableTypeRequiredRule checks that every variable declares a type constraint
+type VariableTypeRequiredRule struct {
+	tflint.DefaultRule
+	docsLink
+}
+
+// variableTypeRequiredRuleConfig is the configuration for the rule
+type variableTypeRequiredRuleConfig struct {
+	AllowAny bool `hclext:"allow_any,optional"`
+}
+
+// NewVariableTypeRequiredRule creates a new rule instance
+func NewVariableTypeRequiredRule() *VariableTypeRequiredRule {
+	return &VariableTypeRequiredRule{}
+}
+
+// Name returns the rule name
+func (r *VariableTypeRequiredRule) Name() string {
+	return "variable_type_required"
+}
+
+// Enabled returns whether the rule is enabled
+func (r *VariableTypeRequiredRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *VariableTypeRequiredRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns a link to detailed information about the rule
+func (r *VariableTypeRequiredRule) Link() string {
+	return r.link(r.Name())
+}
+
+// Check executes the rule checking process
+func (r *VariableTypeRequiredRule) Check(runner tflint.Runner) error {
+	// Decode rule configuration
+	config := variableTypeRequiredRuleConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	files, fileNames, err := sortedFiles(runner)
+	if err != nil {
+		return err
+	}
+
+	for _, fileName := range fileNames {
+		body, ok := files[fileName].Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		for _, block := range body.Blocks {
+			if block.Type != "variable" || len(block.Labels) == 0 {
+				continue
+			}
+
+			rng := block.DefRange()
+			if typeAttr, exists := block.Body.Attributes["type"]; exists {
+				// type = any accepts every value, so it is treated as no constraint unless allowed
+				if config.AllowAny || hcl.ExprAsKeyword(typeAttr.Expr) != "any" {
+					continue
+				}
+				rng = typeAttr.Range()
+			}
+
+			err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("Variable \"%s\" has no type constraint", block.Labels[0]),
+				rng,
+			)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
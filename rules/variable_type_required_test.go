@@ -0,0 +1,92 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestVariableTypeRequiredRule(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		config   string
+		expected helper.Issues
+	}{
+		{
+			name: "typed variable",
+			content: `
+variable "region" {
+  type = string
+}`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "untyped variable",
+			content: `
+variable "region" {
+  default = "ap-northeast-1"
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewVariableTypeRequiredRule(),
+					Message: "Variable \"region\" has no type constraint",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 18},
+					},
+				},
+			},
+		},
+		{
+			name: "any-typed variable",
+			content: `
+variable "settings" {
+  type = any
+}`,
+			expected: helper.Issues{
+				{
+					Rule:    NewVariableTypeRequiredRule(),
+					Message: "Variable \"settings\" has no type constraint",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 13},
+					},
+				},
+			},
+		},
+		{
+			name: "any-typed variable with allow_any",
+			content: `
+variable "settings" {
+  type = any
+}`,
+			config: `
+rule "variable_type_required" {
+  enabled = true
+  allow_any = true
+}`,
+			expected: helper.Issues{},
+		},
+	}
+
+	rule := NewVariableTypeRequiredRule()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			files := map[string]string{"main.tf": test.content}
+			if test.config != "" {
+				files[".tflint.hcl"] = test.config
+			}
+			runner := helper.TestRunner(t, files)
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error occurred: %s", err)
+			}
+
+			helper.AssertIssues(t, test.expected, runner.Issues)
+		})
+	}
+}